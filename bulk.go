@@ -0,0 +1,429 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// planEntry is one issue to create from a plan file
+type planEntry struct {
+	// ID names the entry so that later entries can use it as their parent or epic
+	ID string `yaml:"id"`
+	// Key is written back once the issue is created, and entries with a key are skipped
+	Key         string         `yaml:"key"`
+	Project     string         `yaml:"project"`
+	IssueType   string         `yaml:"type"`
+	Summary     string         `yaml:"summary"`
+	Description string         `yaml:"description"`
+	Assignee    string         `yaml:"assignee"`
+	Parent      string         `yaml:"parent"`
+	Epic        string         `yaml:"epic"`
+	Fields      map[string]any `yaml:"fields"`
+}
+
+// plan is a file of issues to create that created keys can be written back to
+type plan interface {
+	entries() []planEntry
+	setKey(i int, key string)
+	bytes() ([]byte, error)
+}
+
+// loadPlan reads a YAML, CSV or Markdown plan file, chosen by its extension
+func loadPlan(path string) (plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plan: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return parseYAMLPlan(data)
+	case ".csv":
+		return parseCSVPlan(data)
+	case ".md", ".markdown":
+		return parseMarkdownPlan(data)
+	default:
+		return nil, fmt.Errorf("unsupported plan file %s: expected .yaml, .yml, .csv or .md", path)
+	}
+}
+
+// yamlPlan is either a list of entries, or a mapping with default "project" and "type" and a list of "issues"
+type yamlPlan struct {
+	doc   yaml.Node
+	nodes []*yaml.Node
+	list  []planEntry
+}
+
+func parseYAMLPlan(data []byte) (*yamlPlan, error) {
+	p := &yamlPlan{}
+	if err := yaml.Unmarshal(data, &p.doc); err != nil {
+		return nil, fmt.Errorf("failed to parse plan: %w", err)
+	}
+	if len(p.doc.Content) == 0 {
+		return p, nil
+	}
+
+	root := p.doc.Content[0]
+	var defaults planEntry
+	list := root
+	if root.Kind == yaml.MappingNode {
+		if err := root.Decode(&defaults); err != nil {
+			return nil, fmt.Errorf("failed to parse plan: %w", err)
+		}
+		list = mappingValue(root, "issues")
+		if list == nil {
+			return nil, fmt.Errorf("failed to parse plan: expected an \"issues\" list")
+		}
+	}
+	if list.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("failed to parse plan: line %d: expected a list of issues", list.Line)
+	}
+
+	for _, node := range list.Content {
+		if node.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("failed to parse plan: line %d: expected an issue", node.Line)
+		}
+		var entry planEntry
+		if err := node.Decode(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse plan: %w", err)
+		}
+		if entry.Project == "" {
+			entry.Project = defaults.Project
+		}
+		if entry.IssueType == "" {
+			entry.IssueType = defaults.IssueType
+		}
+		p.nodes = append(p.nodes, node)
+		p.list = append(p.list, entry)
+	}
+	return p, nil
+}
+
+// mappingValue returns the value of key in a YAML mapping, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func (p *yamlPlan) entries() []planEntry { return p.list }
+
+func (p *yamlPlan) setKey(i int, key string) {
+	p.list[i].Key = key
+	if value := mappingValue(p.nodes[i], "key"); value != nil {
+		value.Kind, value.Tag, value.Value = yaml.ScalarNode, "!!str", key
+		return
+	}
+	// Put the key first so it stands out
+	p.nodes[i].Content = append([]*yaml.Node{
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: "key"},
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+	}, p.nodes[i].Content...)
+}
+
+func (p *yamlPlan) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&p.doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// csvPlan has a header row naming the columns; columns other than the entry's own become fields
+type csvPlan struct {
+	header []string
+	rows   [][]string
+	keyCol int
+	list   []planEntry
+}
+
+func parseCSVPlan(data []byte) (*csvPlan, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse plan: %w", err)
+	}
+	if len(records) == 0 {
+		return &csvPlan{}, nil
+	}
+
+	p := &csvPlan{header: records[0], rows: records[1:], keyCol: -1}
+	for i, column := range p.header {
+		if strings.EqualFold(strings.TrimSpace(column), "key") {
+			p.keyCol = i
+		}
+	}
+	if p.keyCol < 0 {
+		p.header = append(p.header, "key")
+		p.keyCol = len(p.header) - 1
+	}
+
+	for i, row := range p.rows {
+		for len(row) < len(p.header) {
+			row = append(row, "")
+		}
+		p.rows[i] = row
+
+		entry := planEntry{Fields: map[string]any{}}
+		for j, column := range p.header {
+			value := strings.TrimSpace(row[j])
+			switch strings.ToLower(strings.TrimSpace(column)) {
+			case "id":
+				entry.ID = value
+			case "key":
+				entry.Key = value
+			case "project":
+				entry.Project = value
+			case "type", "issue type", "issuetype":
+				entry.IssueType = value
+			case "summary", "title":
+				entry.Summary = value
+			case "description":
+				entry.Description = value
+			case "assignee":
+				entry.Assignee = value
+			case "parent":
+				entry.Parent = value
+			case "epic":
+				entry.Epic = value
+			default:
+				if value != "" {
+					entry.Fields[strings.TrimSpace(column)] = value
+				}
+			}
+		}
+		p.list = append(p.list, entry)
+	}
+	return p, nil
+}
+
+func (p *csvPlan) entries() []planEntry { return p.list }
+
+func (p *csvPlan) setKey(i int, key string) {
+	p.list[i].Key = key
+	p.rows[i][p.keyCol] = key
+}
+
+func (p *csvPlan) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(append([][]string{p.header}, p.rows...)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// markdownPlan is a sequence of entries, each a YAML frontmatter block between "---" lines followed by
+// the description. If the frontmatter has no summary, the first "# Heading" of the description is used.
+// A "---" line in a description is a horizontal rule unless it starts a frontmatter block.
+type markdownPlan struct {
+	lines []string
+	// starts holds the index of the opening "---" line of each entry
+	starts []int
+	list   []planEntry
+}
+
+// parseFrontmatter parses the frontmatter block opened by the "---" line at start, and returns the entry
+// and the index of the closing "---" line. The block must be a mapping of planEntry's fields.
+func parseFrontmatter(lines []string, start int) (planEntry, int, error) {
+	var entry planEntry
+	end := start + 1
+	for end < len(lines) && strings.TrimSpace(lines[end]) != "---" {
+		end++
+	}
+	if end == len(lines) {
+		return entry, 0, fmt.Errorf("line %d: frontmatter is not closed with \"---\"", start+1)
+	}
+
+	dec := yaml.NewDecoder(strings.NewReader(strings.Join(lines[start+1:end], "\n")))
+	dec.KnownFields(true)
+	if err := dec.Decode(&entry); err != nil && !errors.Is(err, io.EOF) {
+		return entry, 0, fmt.Errorf("line %d: %w", start+1, err)
+	}
+	return entry, end, nil
+}
+
+func parseMarkdownPlan(data []byte) (*markdownPlan, error) {
+	p := &markdownPlan{lines: strings.Split(string(data), "\n")}
+
+	i := 0
+	for i < len(p.lines) && strings.TrimSpace(p.lines[i]) == "" {
+		i++
+	}
+	for i < len(p.lines) {
+		if strings.TrimSpace(p.lines[i]) != "---" {
+			return nil, fmt.Errorf("failed to parse plan: line %d: expected \"---\" to start an issue's frontmatter", i+1)
+		}
+		start := i
+		entry, end, err := parseFrontmatter(p.lines, start)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse plan: %w", err)
+		}
+
+		// The description runs up to the next "---" line that starts a frontmatter block
+		i = end + 1
+		for i < len(p.lines) {
+			if strings.TrimSpace(p.lines[i]) == "---" {
+				if _, next, err := parseFrontmatter(p.lines, i); err == nil && next > i+1 {
+					break
+				}
+			}
+			i++
+		}
+		body := strings.TrimSpace(strings.Join(p.lines[end+1:i], "\n"))
+		if entry.Summary == "" {
+			if heading, rest, _ := strings.Cut(body, "\n"); strings.HasPrefix(heading, "# ") {
+				entry.Summary = strings.TrimSpace(strings.TrimPrefix(heading, "# "))
+				body = strings.TrimSpace(rest)
+			}
+		}
+		if entry.Description != "" && body != "" {
+			return nil, fmt.Errorf("failed to parse plan: line %d: the frontmatter sets a description and is followed by one too, use one or the other", start+1)
+		}
+		if entry.Description == "" {
+			entry.Description = body
+		}
+
+		p.starts = append(p.starts, start)
+		p.list = append(p.list, entry)
+	}
+	return p, nil
+}
+
+func (p *markdownPlan) entries() []planEntry { return p.list }
+
+func (p *markdownPlan) setKey(i int, key string) {
+	p.list[i].Key = key
+	start := p.starts[i]
+	for j := start + 1; j < len(p.lines) && strings.TrimSpace(p.lines[j]) != "---"; j++ {
+		if strings.HasPrefix(p.lines[j], "key:") {
+			p.lines[j] = "key: " + key
+			return
+		}
+	}
+	p.lines = append(p.lines[:start+1], append([]string{"key: " + key}, p.lines[start+1:]...)...)
+	for j := i + 1; j < len(p.starts); j++ {
+		p.starts[j]++
+	}
+}
+
+func (p *markdownPlan) bytes() ([]byte, error) {
+	return []byte(strings.Join(p.lines, "\n")), nil
+}
+
+// createIssues creates the issues in a plan file in order, writing each new key back to the file
+// straight away so that re-running the command after a failure skips the issues already created
+func createIssues(ctx context.Context, path string, defaults planEntry) error {
+	p, err := loadPlan(path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	entries := p.entries()
+
+	// Check every entry before creating anything
+	ids := map[string]int{}
+	for i, entry := range entries {
+		if entry.ID == "" {
+			continue
+		}
+		if _, ok := ids[entry.ID]; ok {
+			return fmt.Errorf("entry %d: duplicate id %q", i+1, entry.ID)
+		}
+		ids[entry.ID] = i
+	}
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Project == "" {
+			entry.Project = defaults.Project
+		}
+		if entry.IssueType == "" {
+			entry.IssueType = defaults.IssueType
+		}
+		if entry.Key != "" {
+			continue
+		}
+		if entry.Project == "" || entry.IssueType == "" || entry.Summary == "" {
+			return fmt.Errorf("entry %d: project, type and summary are required", i+1)
+		}
+		for _, ref := range []string{entry.Parent, entry.Epic} {
+			if j, ok := ids[ref]; ok && j >= i {
+				return fmt.Errorf("entry %d: %q must come before the entries that refer to it", i+1, ref)
+			}
+		}
+	}
+
+	// resolve turns a reference to another entry into its key; anything else is taken to be an issue key
+	resolve := func(ref string) string {
+		if j, ok := ids[ref]; ok {
+			return entries[j].Key
+		}
+		return ref
+	}
+
+	created, skipped := 0, 0
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Key != "" {
+			fmt.Printf("%-15s %s (already created)\n", entry.Key, entry.Summary)
+			skipped++
+			continue
+		}
+
+		issue, err := buildIssue(ctx, client, issueRequest{
+			Project:     entry.Project,
+			IssueType:   entry.IssueType,
+			Summary:     entry.Summary,
+			Description: entry.Description,
+			Assignee:    entry.Assignee,
+			Parent:      resolve(entry.Parent),
+			Epic:        resolve(entry.Epic),
+			Fields:      fieldStrings(entry.Fields),
+		})
+		if err != nil {
+			return fmt.Errorf("entry %d (%s): %w", i+1, entry.Summary, err)
+		}
+
+		createdIssue, _, err := client.Issue.CreateWithContext(ctx, issue)
+		if err != nil {
+			return fmt.Errorf("entry %d (%s): failed to create issue: %w", i+1, entry.Summary, err)
+		}
+		entry.Key = createdIssue.Key
+		created++
+
+		p.setKey(i, createdIssue.Key)
+		data, err := p.bytes()
+		if err != nil {
+			return fmt.Errorf("failed to write %s back to plan: %w", createdIssue.Key, err)
+		}
+		if err := os.WriteFile(path, data, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to write %s back to plan: %w", createdIssue.Key, err)
+		}
+
+		fmt.Printf("%-15s %s (https://%s/browse/%s)\n", createdIssue.Key, entry.Summary, host, createdIssue.Key)
+	}
+
+	fmt.Printf("\nCreated %d issue(s), skipped %d already created\n", created, skipped)
+	return nil
+}
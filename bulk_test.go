@@ -0,0 +1,174 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseYAMLPlan(t *testing.T) {
+	p, err := parseYAMLPlan([]byte(`project: PROJ
+type: Story
+issues:
+  - id: search
+    type: Epic
+    summary: Search
+  # keep this comment
+  - summary: Add search endpoint
+    epic: search
+    fields:
+      Story Points: 3
+      Labels: [backend, api]
+`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entries := p.entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].IssueType != "Epic" || entries[1].IssueType != "Story" || entries[1].Project != "PROJ" {
+		t.Errorf("Expected defaults to apply only where unset, got %+v", entries)
+	}
+	if entries[1].Epic != "search" {
+		t.Errorf("Expected epic reference, got %q", entries[1].Epic)
+	}
+	fields := fieldStrings(entries[1].Fields)
+	if strings.Join(fields["Labels"], ",") != "backend,api" || strings.Join(fields["Story Points"], ",") != "3" {
+		t.Errorf("Unexpected fields: %v", fields)
+	}
+
+	p.setKey(1, "PROJ-2")
+	data, err := p.bytes()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(string(data), "  - key: PROJ-2\n    summary: Add search endpoint") {
+		t.Errorf("Expected key to be written back, got:\n%s", data)
+	}
+	if !strings.Contains(string(data), "# keep this comment") {
+		t.Errorf("Expected comments to be kept, got:\n%s", data)
+	}
+
+	reparsed, err := parseYAMLPlan(data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := reparsed.entries(); got[0].Key != "" || got[1].Key != "PROJ-2" {
+		t.Errorf("Expected only the second entry to have a key, got %+v", got)
+	}
+}
+
+func TestParseCSVPlan(t *testing.T) {
+	p, err := parseCSVPlan([]byte("id,type,summary,epic,Story Points\nsearch,Epic,Search,,\n,Story,Add search endpoint,search,3\n"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entries := p.entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[1].Summary != "Add search endpoint" || entries[1].Epic != "search" || entries[1].Fields["Story Points"] != "3" {
+		t.Errorf("Unexpected entry: %+v", entries[1])
+	}
+	if len(entries[0].Fields) != 0 {
+		t.Errorf("Expected empty cells to be skipped, got %v", entries[0].Fields)
+	}
+
+	p.setKey(0, "PROJ-1")
+	data, err := p.bytes()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := "id,type,summary,epic,Story Points,key\nsearch,Epic,Search,,,PROJ-1\n,Story,Add search endpoint,search,3,\n"
+	if string(data) != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, data)
+	}
+}
+
+func TestParseMarkdownPlan(t *testing.T) {
+	p, err := parseMarkdownPlan([]byte(`---
+id: search
+type: Epic
+---
+# Search
+---
+type: Story
+epic: search
+summary: Add search endpoint
+---
+Expose search over the REST API.
+
+More detail.
+`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entries := p.entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Summary != "Search" || entries[0].Description != "" {
+		t.Errorf("Expected the heading to be the summary, got %+v", entries[0])
+	}
+	if entries[1].Summary != "Add search endpoint" || entries[1].Description != "Expose search over the REST API.\n\nMore detail." {
+		t.Errorf("Unexpected entry: %+v", entries[1])
+	}
+
+	p.setKey(0, "PROJ-1")
+	p.setKey(1, "PROJ-2")
+	p.setKey(1, "PROJ-3")
+	data, err := p.bytes()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "---\nkey: PROJ-1\nid: search\n") || !strings.Contains(string(data), "# Search\n---\nkey: PROJ-3\ntype: Story\n") {
+		t.Errorf("Expected keys to be written back, got:\n%s", data)
+	}
+}
+
+func TestParseMarkdownPlan_HorizontalRule(t *testing.T) {
+	p, err := parseMarkdownPlan([]byte(`---
+summary: Add search endpoint
+---
+Expose search over the REST API.
+
+---
+
+Note: the old endpoint stays.
+---
+summary: Paginate results
+---
+`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entries := p.entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %+v", entries)
+	}
+	if want := "Expose search over the REST API.\n\n---\n\nNote: the old endpoint stays."; entries[0].Description != want {
+		t.Errorf("Expected the rule to stay in the description, got %q", entries[0].Description)
+	}
+	if entries[1].Summary != "Paginate results" {
+		t.Errorf("Unexpected entry: %+v", entries[1])
+	}
+}
+
+func TestParseMarkdownPlan_Invalid(t *testing.T) {
+	if _, err := parseMarkdownPlan([]byte("---\nsummary: Search\ndescription: Details\n---\nMore details\n")); err == nil {
+		t.Error("Expected an error for a description in both the frontmatter and the body")
+	}
+	if _, err := parseMarkdownPlan([]byte("---\nsumary: Search\n---\n")); err == nil {
+		t.Error("Expected an error for an unknown frontmatter field")
+	}
+	if _, err := parseMarkdownPlan([]byte("# Search\n")); err == nil {
+		t.Error("Expected an error for a missing frontmatter block")
+	}
+	if _, err := parseMarkdownPlan([]byte("---\nsummary: Search\n")); err == nil {
+		t.Error("Expected an error for an unclosed frontmatter block")
+	}
+}
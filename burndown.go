@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// burndownWidth is the width in characters of the longest bar of a burndown chart
+const burndownWidth = 50
+
+// burndownDay is the scope of a sprint and how much of it was done at the end of a day, with the
+// remaining work an even burndown would leave
+type burndownDay struct {
+	Date  time.Time
+	Scope float64
+	Done  float64
+	Ideal float64
+}
+
+// Remaining is the work in the sprint not yet done
+func (d burndownDay) Remaining() float64 {
+	return d.Scope - d.Done
+}
+
+// resolvedBy reports whether an issue was resolved at a time, from the resolution changes in its
+// changelog, so that an issue reopened later counts as done until it was reopened
+func resolvedBy(issue jira.Issue, t time.Time) bool {
+	type change struct {
+		at       time.Time
+		resolved bool
+	}
+	var changes []change
+	if issue.Changelog != nil {
+		for _, history := range issue.Changelog.Histories {
+			for _, item := range history.Items {
+				if item.Field != "resolution" {
+					continue
+				}
+				if at, err := time.Parse(jiraTimeLayout, history.Created); err == nil {
+					changes = append(changes, change{at: at, resolved: item.ToString != ""})
+				}
+			}
+		}
+	}
+	if len(changes) == 0 {
+		resolved := time.Time(issue.Fields.Resolutiondate)
+		return !resolved.IsZero() && !resolved.After(t)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].at.Before(changes[j].at) })
+	resolved := false
+	for _, c := range changes {
+		if c.at.After(t) {
+			break
+		}
+		resolved = c.resolved
+	}
+	return resolved
+}
+
+// computeBurndown reconstructs the scope and done work of a sprint at the end of each day from its start
+// to its end, or to now if that is sooner. Work is story points, or issues if pointsFieldID is empty.
+func computeBurndown(issues []jira.Issue, sprint jira.Sprint, pointsFieldID string, now time.Time) []burndownDay {
+	work := func(issue jira.Issue) float64 {
+		if pointsFieldID == "" {
+			return 1
+		}
+		points, _ := storyPoints(issue, pointsFieldID)
+		return points
+	}
+	at := func(t time.Time) (scope, done float64) {
+		for _, issue := range issues {
+			if sprintAddedAt(issue, sprint.ID).After(t) {
+				continue
+			}
+			scope += work(issue)
+			if resolvedBy(issue, t) {
+				done += work(issue)
+			}
+		}
+		return scope, done
+	}
+
+	start := *sprint.StartDate
+	end := start
+	if sprint.EndDate != nil {
+		end = *sprint.EndDate
+	}
+	if sprint.CompleteDate != nil {
+		end = *sprint.CompleteDate
+	}
+	firstDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	lastDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, start.Location())
+	totalDays := int(math.Round(lastDay.Sub(firstDay).Hours()/24)) + 1
+
+	scope, done := at(start)
+	committed := scope - done
+
+	var days []burndownDay
+	for i := 0; i < totalDays; i++ {
+		day := firstDay.AddDate(0, 0, i)
+		if day.After(now) {
+			break
+		}
+		cutoff := day.AddDate(0, 0, 1)
+		if cutoff.After(now) {
+			cutoff = now
+		}
+		if cutoff.After(end) {
+			cutoff = end
+		}
+		scope, done := at(cutoff)
+		ideal := committed
+		if totalDays > 1 {
+			ideal = committed * float64(totalDays-1-i) / float64(totalDays-1)
+		}
+		days = append(days, burndownDay{Date: day, Scope: scope, Done: done, Ideal: math.Round(ideal*10) / 10})
+	}
+	return days
+}
+
+// formatBurndownChart draws the remaining work of each day as a bar of "#", with "|" where an even
+// burndown would be
+func formatBurndownChart(sprint jira.Sprint, days []burndownDay, unit string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s burndown (remaining %s)\n\n", sprint.Name, unit)
+
+	top := 0.0
+	for _, d := range days {
+		top = math.Max(top, math.Max(d.Scope, d.Ideal))
+	}
+	scale := func(v float64) int {
+		if top == 0 {
+			return 0
+		}
+		return int(math.Round(v / top * burndownWidth))
+	}
+
+	for _, d := range days {
+		bar := []byte(strings.Repeat("#", scale(d.Remaining())) + strings.Repeat(" ", burndownWidth-scale(d.Remaining())+1))
+		if i := scale(d.Ideal); i < len(bar) {
+			bar[i] = '|'
+		}
+		fmt.Fprintf(&sb, "%s %s %5g (scope %g)\n", d.Date.Format("Mon 01-02"), string(bar), d.Remaining(), d.Scope)
+	}
+	sb.WriteString("\n# remaining, | ideal\n")
+	return sb.String()
+}
+
+// writeBurndownCSV writes a row per day with the scope, done, remaining and ideal remaining work, which
+// also gives a burnup chart from the scope and done columns
+func writeBurndownCSV(w io.Writer, days []burndownDay) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "scope", "done", "remaining", "ideal"}); err != nil {
+		return err
+	}
+	for _, d := range days {
+		row := []string{d.Date.Format(time.DateOnly), fmt.Sprint(d.Scope), fmt.Sprint(d.Done), fmt.Sprint(d.Remaining()), fmt.Sprint(d.Ideal)}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// printBurndown prints the burndown of a sprint, by default the board's active sprint, as a chart or csv
+func printBurndown(ctx context.Context, board, sprint string, countIssues bool, output string) error {
+	s, err := findSprint(ctx, client, board, sprint)
+	if err != nil {
+		return err
+	}
+	if s.StartDate == nil {
+		return fmt.Errorf("sprint %s has not started", s.Name)
+	}
+
+	pointsFieldID := ""
+	if !countIssues {
+		pointsFieldID = storyPointsFieldID(ctx, client)
+	}
+	fields := []string{"created", "resolutiondate"}
+	if pointsFieldID != "" {
+		fields = append(fields, pointsFieldID)
+	}
+	issues, err := searchIssues(ctx, client, fmt.Sprintf("sprint = %d", s.ID), &jira.SearchOptions{Fields: fields, Expand: "changelog"})
+	if err != nil {
+		return err
+	}
+	if err := completeChangelogs(ctx, client, issues); err != nil {
+		return err
+	}
+
+	days := computeBurndown(issues, *s, pointsFieldID, time.Now())
+	if output == "csv" {
+		return writeBurndownCSV(os.Stdout, days)
+	}
+	unit := "story points"
+	if pointsFieldID == "" {
+		unit = "issues"
+	}
+	fmt.Print(formatBurndownChart(*s, days, unit))
+	return nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestComputeBurndown(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, 10, day, hour, 0, 0, 0, time.UTC)
+	}
+	issue := func(key string, points float64, histories ...jira.ChangelogHistory) jira.Issue {
+		i := testIssue(key, "Story", key)
+		i.Fields.Created = jira.Time(at(1, 9))
+		i.Fields.Unknowns = map[string]any{"customfield_10002": points}
+		i.Changelog = &jira.Changelog{Histories: histories}
+		return i
+	}
+	change := func(when time.Time, field, from, to string) jira.ChangelogHistory {
+		return jira.ChangelogHistory{Created: when.Format(jiraTimeLayout), Items: []jira.ChangelogItems{
+			{Field: field, From: from, To: to, ToString: to},
+		}}
+	}
+	issues := []jira.Issue{
+		issue("PROJ-1", 5, change(at(13, 15), "resolution", "", "Done")),
+		issue("PROJ-2", 3, change(at(13, 10), "resolution", "", "Done"), change(at(14, 10), "resolution", "Done", "")),
+		issue("PROJ-3", 2),
+		issue("PROJ-4", 4, change(at(14, 12), "Sprint", "", "42")),
+	}
+
+	start, end := at(12, 9), at(16, 17)
+	sprint := jira.Sprint{ID: 42, Name: "Sprint 42", StartDate: &start, EndDate: &end}
+	days := computeBurndown(issues, sprint, "customfield_10002", at(14, 18))
+
+	wantDays := []burndownDay{
+		{Date: at(12, 0), Scope: 10, Done: 0, Ideal: 10},
+		{Date: at(13, 0), Scope: 10, Done: 8, Ideal: 7.5},
+		{Date: at(14, 0), Scope: 14, Done: 5, Ideal: 5},
+	}
+	if len(days) != len(wantDays) {
+		t.Fatalf("Expected %d days, got %+v", len(wantDays), days)
+	}
+	for i := range wantDays {
+		if days[i] != wantDays[i] {
+			t.Errorf("Day %d: expected %+v, got %+v", i, wantDays[i], days[i])
+		}
+	}
+
+	var out bytes.Buffer
+	if err := writeBurndownCSV(&out, days); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantCSV := "date,scope,done,remaining,ideal\n2026-10-12,10,0,10,10\n2026-10-13,10,8,2,7.5\n2026-10-14,14,5,9,5\n"
+	if out.String() != wantCSV {
+		t.Errorf("Expected CSV:\n%s\ngot:\n%s", wantCSV, out.String())
+	}
+
+	want := `Sprint 42 burndown (remaining story points)
+
+Mon 10-12 ####################################|                  10 (scope 10)
+Tue 10-13 #######                    |                            2 (scope 10)
+Wed 10-14 ##################|#############                        9 (scope 14)
+
+# remaining, | ideal
+`
+	if got := formatBurndownChart(sprint, days, "story points"); got != want {
+		t.Errorf("Expected chart:\n%s\ngot:\n%s", want, got)
+	}
+}
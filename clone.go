@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// cloneOptions controls what clone-issue copies
+type cloneOptions struct {
+	// Project is the project to clone into, the source's project if empty
+	Project         string
+	SummaryPrefix   string
+	WithSubtasks    bool
+	WithLinks       bool
+	WithAttachments bool
+	// IssueType is the clone's issue type, the source's if empty
+	IssueType string
+	// Fields are set on the clone instead of the source's values, keyed by display name or field ID
+	Fields map[string][]string
+	// parent is the key of the clone's parent, when cloning sub-tasks
+	parent string
+}
+
+// cloneResult is what was created by a clone, and what could not be copied
+type cloneResult struct {
+	Key      string
+	Subtasks []string
+	Warnings []string
+}
+
+// notCloned lists the fields that are not copied as they are: the clone sets them itself, or they are copied separately
+var notCloned = map[string]bool{
+	"project":    true,
+	"issuetype":  true,
+	"summary":    true,
+	"reporter":   true,
+	"parent":     true,
+	"issuelinks": true,
+	"attachment": true,
+}
+
+// cloneIssue creates a copy of an issue, copying the fields that are on the target's create screen
+// and linking the copy to the source with a "clones" link
+func cloneIssue(ctx context.Context, client *jira.Client, key string, opts cloneOptions) (*cloneResult, error) {
+	source, _, err := client.Issue.GetWithContext(ctx, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	project := opts.Project
+	if project == "" {
+		project = source.Fields.Project.Key
+	}
+	issueType := opts.IssueType
+	if issueType == "" {
+		issueType = source.Fields.Type.Name
+	}
+
+	fields, err := getCreateMeta(ctx, client, project, issueType)
+	if err != nil {
+		return nil, err
+	}
+
+	// The typed fields marshal back to the shape the API returned them in, along with the custom fields
+	data, err := json.Marshal(source.Fields)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]any
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+
+	unknowns, err := resolveFields(ctx, client, fields, opts.Fields, project, issueType)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &cloneResult{}
+	var missing []string
+	for _, field := range fields {
+		if _, ok := unknowns[field.ID]; ok {
+			continue
+		}
+		// Sprints are left out so the clone starts in the backlog
+		if notCloned[field.ID] || field.Schema.Custom == "com.pyxis.greenhopper.jira:gh-sprint" {
+			continue
+		}
+		value, ok := cloneFieldValue(field, values[field.ID])
+		if ok {
+			unknowns[field.ID] = value
+			continue
+		}
+		if values[field.ID] != nil && !isEmptyValue(values[field.ID]) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: value is not available in %s, not copied", field.Name, project))
+		}
+		if field.Required && !field.HasDefaultValue {
+			missing = append(missing, fmt.Sprintf("%q", field.Name))
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("cannot clone %s into %s, required fields have no value: %s", key, project, strings.Join(missing, ", "))
+	}
+
+	// A clone in the same project stays under the same parent, such as a sub-task's issue or a story's epic on Cloud
+	parent := opts.parent
+	if parent == "" && source.Fields.Parent != nil && project == source.Fields.Project.Key {
+		parent = source.Fields.Parent.Key
+	}
+	if _, ok := unknowns["parent"]; !ok && parent != "" {
+		unknowns["parent"] = map[string]any{"key": parent}
+	}
+
+	clone, _, err := client.Issue.CreateWithContext(ctx, &jira.Issue{
+		Fields: &jira.IssueFields{
+			Project:  jira.Project{Key: project},
+			Type:     jira.IssueType{Name: issueType},
+			Summary:  opts.SummaryPrefix + source.Fields.Summary,
+			Unknowns: unknowns,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clone of %s: %w", key, err)
+	}
+	result.Key = clone.Key
+
+	// The issue in "inwardIssue" is the one that gets the link's outward description, so this reads "clone clones source"
+	if _, err := client.Issue.AddLinkWithContext(ctx, &jira.IssueLink{
+		Type:         jira.IssueLinkType{Name: "Cloners"},
+		InwardIssue:  &jira.Issue{Key: clone.Key},
+		OutwardIssue: &jira.Issue{Key: source.Key},
+	}); err != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("failed to link %s to %s: %v", clone.Key, source.Key, err))
+	}
+
+	if opts.WithLinks {
+		for _, link := range source.Fields.IssueLinks {
+			newLink := &jira.IssueLink{Type: jira.IssueLinkType{Name: link.Type.Name}}
+			var other string
+			if link.OutwardIssue != nil {
+				other = link.OutwardIssue.Key
+				newLink.InwardIssue, newLink.OutwardIssue = &jira.Issue{Key: clone.Key}, &jira.Issue{Key: other}
+			} else if link.InwardIssue != nil {
+				other = link.InwardIssue.Key
+				newLink.InwardIssue, newLink.OutwardIssue = &jira.Issue{Key: other}, &jira.Issue{Key: clone.Key}
+			}
+			if other == "" || other == clone.Key {
+				continue
+			}
+			if _, err := client.Issue.AddLinkWithContext(ctx, newLink); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("failed to copy %s link to %s: %v", link.Type.Name, other, err))
+			}
+		}
+	}
+
+	if opts.WithAttachments {
+		for _, attachment := range source.Fields.Attachments {
+			if err := copyAttachment(ctx, client, attachment, clone.Key); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("failed to copy attachment %s: %v", attachment.Filename, err))
+			}
+		}
+	}
+
+	if opts.WithSubtasks {
+		for _, subtask := range source.Fields.Subtasks {
+			sub, err := cloneIssue(ctx, client, subtask.Key, cloneOptions{
+				Project:         project,
+				WithLinks:       opts.WithLinks,
+				WithAttachments: opts.WithAttachments,
+				parent:          clone.Key,
+			})
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("failed to clone sub-task %s: %v", subtask.Key, err))
+				continue
+			}
+			result.Subtasks = append(result.Subtasks, sub.Key)
+			result.Warnings = append(result.Warnings, sub.Warnings...)
+		}
+	}
+
+	return result, nil
+}
+
+// copyAttachment downloads an attachment and uploads it to another issue
+func copyAttachment(ctx context.Context, client *jira.Client, attachment *jira.Attachment, key string) error {
+	resp, err := client.Issue.DownloadAttachmentWithContext(ctx, attachment.ID)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _, err = client.Issue.PostAttachmentWithContext(ctx, key, resp.Body, attachment.Filename)
+	return err
+}
+
+// cloneFieldValue reduces a field value as returned by the API to what the create API accepts,
+// and reports false if there is nothing to copy or the value is not allowed in the target
+func cloneFieldValue(field metaField, value any) (any, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case []any:
+		items := []any{}
+		for _, item := range v {
+			if c, ok := cloneFieldValue(field, item); ok {
+				items = append(items, c)
+			}
+		}
+		return items, len(items) > 0
+	case map[string]any:
+		return cloneObject(field, v)
+	default:
+		return v, true
+	}
+}
+
+// cloneObject reduces an object, such as a user, option, version or component, to a reference
+func cloneObject(field metaField, v map[string]any) (any, bool) {
+	if field.ID == "timetracking" {
+		estimates := map[string]any{}
+		for _, name := range []string{"originalEstimate", "remainingEstimate"} {
+			if estimate, ok := v[name]; ok {
+				estimates[name] = estimate
+			}
+		}
+		return estimates, len(estimates) > 0
+	}
+	if accountID, ok := v["accountId"].(string); ok && accountID != "" {
+		return map[string]any{"accountId": accountID}, true
+	}
+
+	id, _ := v["id"].(string)
+	if len(field.AllowedValues) > 0 {
+		// IDs of versions, components and options differ between projects, so fall back to matching by name
+		allowed := findAllowedValue(field.AllowedValues, id, objectLabel(v))
+		if allowed == nil {
+			return nil, false
+		}
+		ref := map[string]any{"id": allowed.ID}
+		if child, ok := v["child"].(map[string]any); ok {
+			childID, _ := child["id"].(string)
+			if c := findAllowedValue(allowed.Children, childID, objectLabel(child)); c != nil {
+				ref["child"] = map[string]any{"id": c.ID}
+			}
+		}
+		return ref, true
+	}
+
+	if id != "" {
+		return map[string]any{"id": id}, true
+	}
+	if name, ok := v["name"].(string); ok && name != "" {
+		return map[string]any{"name": name}, true
+	}
+	if key, ok := v["key"].(string); ok && key != "" {
+		return map[string]any{"key": key}, true
+	}
+	return v, len(v) > 0
+}
+
+// objectLabel is the name shown for an object: an option's value, or anything else's name
+func objectLabel(v map[string]any) string {
+	if value, ok := v["value"].(string); ok {
+		return value
+	}
+	name, _ := v["name"].(string)
+	return name
+}
+
+// findAllowedValue finds an allowed value by ID, or failing that by label ignoring case
+func findAllowedValue(values []allowedValue, id, label string) *allowedValue {
+	for i, value := range values {
+		if id != "" && value.ID == id {
+			return &values[i]
+		}
+	}
+	for i, value := range values {
+		if label != "" && strings.EqualFold(value.label(), label) {
+			return &values[i]
+		}
+	}
+	return nil
+}
+
+// isEmptyValue reports whether a field value has nothing in it
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// formatCloneResult describes a clone for the user
+func formatCloneResult(host, source string, result *cloneResult) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Cloned %s to %s (https://%s/browse/%s)\n", source, result.Key, host, result.Key)
+	for _, key := range result.Subtasks {
+		fmt.Fprintf(&sb, "  Sub-task %s\n", key)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(&sb, "Warning: %s\n", warning)
+	}
+	return sb.String()
+}
+
+// cloneIssueCommand clones the current issue and prints the result
+func cloneIssueCommand(ctx context.Context, opts cloneOptions) error {
+	result, err := cloneIssue(ctx, client, issueKey, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatCloneResult(host, issueKey, result))
+	return nil
+}
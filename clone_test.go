@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestCloneFieldValue(t *testing.T) {
+	versions := metaField{Name: "Fix Version/s", Schema: jira.FieldSchema{Type: "array", Items: "version"}, AllowedValues: []allowedValue{{ID: "200", Name: "1.0"}}}
+	cascade := metaField{Name: "Area", Schema: jira.FieldSchema{Type: "option-with-child"}, AllowedValues: []allowedValue{
+		{ID: "1", Value: "Backend", Children: []allowedValue{{ID: "2", Value: "API"}}},
+	}}
+
+	tests := []struct {
+		name   string
+		field  metaField
+		value  any
+		want   any
+		wantOK bool
+	}{
+		{"string", metaField{}, "text", "text", true},
+		{"empty string", metaField{}, "", nil, false},
+		{"number", metaField{}, 3.0, 3.0, true},
+		{"cloud user", metaField{}, map[string]any{"accountId": "abc", "displayName": "Alice"}, map[string]any{"accountId": "abc"}, true},
+		{"server user", metaField{}, map[string]any{"name": "alice", "displayName": "Alice", "avatarUrls": map[string]any{}}, map[string]any{"name": "alice"}, true},
+		{"version by id", versions, []any{map[string]any{"id": "200", "name": "1.0"}}, []any{map[string]any{"id": "200"}}, true},
+		{"version by name", versions, []any{map[string]any{"id": "100", "name": "1.0"}}, []any{map[string]any{"id": "200"}}, true},
+		{"version not in target", versions, []any{map[string]any{"id": "100", "name": "0.9"}}, []any{}, false},
+		{"cascading option", cascade, map[string]any{"id": "1", "value": "Backend", "child": map[string]any{"id": "2", "value": "API"}},
+			map[string]any{"id": "1", "child": map[string]any{"id": "2"}}, true},
+		{"time tracking", metaField{ID: "timetracking"}, map[string]any{"originalEstimate": "1d", "originalEstimateSeconds": 28800.0},
+			map[string]any{"originalEstimate": "1d"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := cloneFieldValue(tt.field, tt.value)
+			if ok != tt.wantOK || (ok && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("cloneFieldValue() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCloneIssue(t *testing.T) {
+	mux := http.NewServeMux()
+	createMetaHandler(mux)
+	mux.HandleFunc("/rest/api/2/issue/PROJ-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key": "PROJ-1", "fields": {
+			"project": {"key": "PROJ"},
+			"issuetype": {"name": "Bug"},
+			"summary": "Login broken",
+			"reporter": {"name": "alice"},
+			"priority": {"id": "1", "name": "High"},
+			"labels": ["login"],
+			"customfield_10002": 5,
+			"customfield_10003": {"id": "10", "value": "Critical", "self": "https://jira/option/10"}
+		}}`))
+	})
+	var created map[string]any
+	mux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Fields map[string]any `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		created = body.Fields
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"key": "PROJ-2"}`))
+	})
+	var link string
+	mux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		link = string(data)
+		w.WriteHeader(http.StatusCreated)
+	})
+	c := newTestClient(t, "Server", mux)
+
+	result, err := cloneIssue(context.Background(), c, "PROJ-1", cloneOptions{SummaryPrefix: "CLONE - "})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Key != "PROJ-2" || len(result.Warnings) != 0 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	if created["summary"] != "CLONE - Login broken" {
+		t.Errorf("Expected prefixed summary, got %v", created["summary"])
+	}
+	if _, ok := created["reporter"]; ok {
+		t.Errorf("Expected reporter not to be copied, got %v", created["reporter"])
+	}
+	wantFields := map[string]any{
+		"priority":          map[string]any{"id": "1"},
+		"labels":            []any{"login"},
+		"customfield_10002": 5.0,
+		"customfield_10003": map[string]any{"id": "10"},
+	}
+	for id, want := range wantFields {
+		if !reflect.DeepEqual(created[id], want) {
+			t.Errorf("Expected %s to be %v, got %v", id, want, created[id])
+		}
+	}
+
+	var gotLink struct {
+		Type         struct{ Name string }
+		InwardIssue  struct{ Key string }
+		OutwardIssue struct{ Key string }
+	}
+	json.Unmarshal([]byte(link), &gotLink)
+	if gotLink.Type.Name != "Cloners" || gotLink.InwardIssue.Key != "PROJ-2" || gotLink.OutwardIssue.Key != "PROJ-1" {
+		t.Errorf("Expected PROJ-2 to clone PROJ-1, got %s", link)
+	}
+}
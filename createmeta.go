@@ -0,0 +1,452 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// errCreateMetaUnavailable is returned when the create screen could not be fetched at all, as opposed to
+// the project or issue type not being found on it
+var errCreateMetaUnavailable = errors.New("failed to get create meta")
+
+// metaField describes a field on the create screen of an issue type
+type metaField struct {
+	ID              string           `json:"fieldId"`
+	Key             string           `json:"key"`
+	Name            string           `json:"name"`
+	Required        bool             `json:"required"`
+	HasDefaultValue bool             `json:"hasDefaultValue"`
+	Schema          jira.FieldSchema `json:"schema"`
+	AllowedValues   []allowedValue   `json:"allowedValues"`
+}
+
+// allowedValue is one of the values a field accepts, such as a select list option, priority or version
+type allowedValue struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Value    string         `json:"value"`
+	Children []allowedValue `json:"children"`
+}
+
+func (v allowedValue) label() string {
+	if v.Value != "" {
+		return v.Value
+	}
+	return v.Name
+}
+
+// createMetaPage is a page of the createmeta response; Data Center returns "values", Cloud "issueTypes" or "fields"
+type createMetaPage[T any] struct {
+	Values     []T  `json:"values"`
+	IssueTypes []T  `json:"issueTypes"`
+	Fields     []T  `json:"fields"`
+	Total      int  `json:"total"`
+	IsLast     bool `json:"isLast"`
+}
+
+func (p createMetaPage[T]) items() []T {
+	return append(append(p.Values, p.IssueTypes...), p.Fields...)
+}
+
+// getCreateMetaPages fetches all pages of a createmeta endpoint
+func getCreateMetaPages[T any](ctx context.Context, client *jira.Client, endpoint string) ([]T, error) {
+	var all []T
+	for {
+		req, err := client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s?startAt=%d&maxResults=100", endpoint, len(all)), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page createMetaPage[T]
+		if _, err := client.Do(req, &page); err != nil {
+			return nil, err
+		}
+		items := page.items()
+		all = append(all, items...)
+		if len(items) == 0 || page.IsLast || len(all) >= page.Total {
+			return all, nil
+		}
+	}
+}
+
+// getCreateMetaIssueTypes returns the issue types the current user can create in a project
+func getCreateMetaIssueTypes(ctx context.Context, client *jira.Client, projectKey string) ([]jira.IssueType, error) {
+	issueTypes, err := getCreateMetaPages[jira.IssueType](ctx, client, fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes", url.PathEscape(projectKey)))
+	if err == nil {
+		return issueTypes, nil
+	}
+
+	// Older Jira versions only have the single createmeta endpoint
+	project, _, err := client.Project.GetWithContext(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return project.IssueTypes, nil
+}
+
+// getCreateMeta returns the fields on the create screen of an issue type in a project
+func getCreateMeta(ctx context.Context, client *jira.Client, projectKey, issueTypeName string) ([]metaField, error) {
+	issueTypes, err := getCreateMetaPages[jira.IssueType](ctx, client, fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes", url.PathEscape(projectKey)))
+	if err != nil {
+		// Older Jira versions only have the single createmeta endpoint
+		return getLegacyCreateMeta(ctx, client, projectKey, issueTypeName)
+	}
+
+	var issueTypeID string
+	var names []string
+	for _, issueType := range issueTypes {
+		if strings.EqualFold(issueType.Name, issueTypeName) {
+			issueTypeID = issueType.ID
+		}
+		names = append(names, fmt.Sprintf("%q", issueType.Name))
+	}
+	if issueTypeID == "" {
+		return nil, fmt.Errorf("issue type %q not found in project %s. Available issue types: %s", issueTypeName, projectKey, strings.Join(names, ", "))
+	}
+
+	fields, err := getCreateMetaPages[metaField](ctx, client, fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes/%s", url.PathEscape(projectKey), issueTypeID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errCreateMetaUnavailable, err)
+	}
+	for i := range fields {
+		if fields[i].ID == "" {
+			fields[i].ID = fields[i].Key
+		}
+	}
+	return fields, nil
+}
+
+// getLegacyCreateMeta uses the createmeta endpoint that was removed in Jira Data Center 9
+func getLegacyCreateMeta(ctx context.Context, client *jira.Client, projectKey, issueTypeName string) ([]metaField, error) {
+	meta, _, err := client.Issue.GetCreateMetaWithOptionsWithContext(ctx, &jira.GetQueryOptions{
+		ProjectKeys: projectKey,
+		Expand:      "projects.issuetypes.fields",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errCreateMetaUnavailable, err)
+	}
+
+	project := meta.GetProjectWithKey(projectKey)
+	if project == nil {
+		return nil, fmt.Errorf("project %s not found or you do not have permission to create issues in it", projectKey)
+	}
+	issueType := project.GetIssueTypeWithName(issueTypeName)
+	if issueType == nil {
+		var names []string
+		for _, issueType := range project.IssueTypes {
+			names = append(names, fmt.Sprintf("%q", issueType.Name))
+		}
+		return nil, fmt.Errorf("issue type %q not found in project %s. Available issue types: %s", issueTypeName, projectKey, strings.Join(names, ", "))
+	}
+
+	var fields []metaField
+	for id, raw := range issueType.Fields {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return nil, err
+		}
+		var field metaField
+		if err := json.Unmarshal(data, &field); err != nil {
+			return nil, fmt.Errorf("failed to parse create meta for field %s: %w", id, err)
+		}
+		field.ID = id
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	return fields, nil
+}
+
+// findMetaField finds a field by display name or ID, ignoring case
+func findMetaField(fields []metaField, nameOrID string) (*metaField, bool) {
+	for i, field := range fields {
+		if strings.EqualFold(field.Name, nameOrID) || strings.EqualFold(field.ID, nameOrID) {
+			return &fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// fieldValue converts the raw values given for a field into the JSON its schema expects
+func fieldValue(ctx context.Context, client *jira.Client, field metaField, raw []string) (any, error) {
+	if field.Schema.Type == "array" {
+		values := []any{}
+		for _, r := range raw {
+			for item := range strings.SplitSeq(r, ",") {
+				item = strings.TrimSpace(item)
+				if item == "" {
+					continue
+				}
+				value, err := itemValue(ctx, client, field, field.Schema.Items, item)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+		}
+		return values, nil
+	}
+
+	if len(raw) != 1 {
+		return nil, fmt.Errorf("field %q takes a single value, got %d", field.Name, len(raw))
+	}
+	return itemValue(ctx, client, field, field.Schema.Type, strings.TrimSpace(raw[0]))
+}
+
+// itemValue converts a single raw value of the given schema type
+func itemValue(ctx context.Context, client *jira.Client, field metaField, schemaType, raw string) (any, error) {
+	switch schemaType {
+	case "number":
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %q expects a number, got %q", field.Name, raw)
+		}
+		return n, nil
+	case "date":
+		if _, err := time.Parse(time.DateOnly, raw); err != nil {
+			return nil, fmt.Errorf("field %q expects a date (YYYY-MM-DD), got %q", field.Name, raw)
+		}
+		return raw, nil
+	case "user":
+		user, err := resolveUser(ctx, client, raw)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", field.Name, err)
+		}
+		return userRef(ctx, client, user), nil
+	case "option-with-child":
+		// Cascading select lists are given as "Parent > Child"
+		parentLabel, childLabel, hasChild := strings.Cut(raw, ">")
+		parent, err := matchAllowedValue(field, field.AllowedValues, strings.TrimSpace(parentLabel))
+		if err != nil {
+			return nil, err
+		}
+		if !hasChild {
+			return parent, nil
+		}
+		var children []allowedValue
+		for _, v := range field.AllowedValues {
+			if strings.EqualFold(v.label(), strings.TrimSpace(parentLabel)) {
+				children = v.Children
+			}
+		}
+		child, err := matchAllowedValue(field, children, strings.TrimSpace(childLabel))
+		if err != nil {
+			return nil, err
+		}
+		parent["child"] = child
+		return parent, nil
+	case "option", "priority", "version", "component", "resolution", "securitylevel", "issuetype":
+		if len(field.AllowedValues) > 0 {
+			return matchAllowedValue(field, field.AllowedValues, raw)
+		}
+		if schemaType == "option" {
+			return map[string]any{"value": raw}, nil
+		}
+		return map[string]any{"name": raw}, nil
+	case "group":
+		return map[string]any{"name": raw}, nil
+	case "project":
+		return map[string]any{"key": raw}, nil
+	case "issuelink", "issuelinks":
+		return map[string]any{"key": raw}, nil
+	default:
+		// string, any (e.g. Epic Link), datetime and anything unknown are sent as-is
+		return raw, nil
+	}
+}
+
+// matchAllowedValue finds the allowed value with the given label or ID and returns a reference to it by ID
+func matchAllowedValue(field metaField, allowed []allowedValue, raw string) (map[string]any, error) {
+	var labels []string
+	for _, v := range allowed {
+		if strings.EqualFold(v.label(), raw) || v.ID == raw {
+			return map[string]any{"id": v.ID}, nil
+		}
+		labels = append(labels, fmt.Sprintf("%q", v.label()))
+	}
+	return nil, fmt.Errorf("invalid value %q for field %q. Allowed values: %s", raw, field.Name, strings.Join(labels, ", "))
+}
+
+// issueRequest describes an issue to create
+type issueRequest struct {
+	Project     string
+	IssueType   string
+	Summary     string
+	Description string
+	Assignee    string
+	// Parent is the key of the parent issue, for sub-tasks
+	Parent string
+	// Epic is the key of the epic the issue belongs to
+	Epic string
+	// Fields holds additional field values keyed by display name or field ID
+	Fields map[string][]string
+}
+
+// setByRequest lists the fields that buildIssue fills in itself, or that Jira fills in when missing
+var setByRequest = map[string]bool{"project": true, "issuetype": true, "summary": true, "reporter": true}
+
+// buildIssue turns the request into an issue ready to create: mentions and the assignee are resolved,
+// additional fields are coerced to their schema, and required fields are checked against the create screen
+func buildIssue(ctx context.Context, client *jira.Client, req issueRequest) (*jira.Issue, error) {
+	givenFields := len(req.Fields) > 0
+	if err := applyTemplate(ctx, client, &req); err != nil {
+		return nil, err
+	}
+
+	// Convert @handles in the description into mentions
+	description, err := resolveMentions(ctx, client, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := &jira.Issue{
+		Fields: &jira.IssueFields{
+			Project: jira.Project{
+				Key: req.Project,
+			},
+			Summary:     req.Summary,
+			Description: description,
+			Type: jira.IssueType{
+				Name: req.IssueType,
+			},
+			Unknowns: map[string]any{},
+		},
+	}
+
+	// Add assignee if provided
+	if req.Assignee != "" {
+		user, err := resolveAssignee(ctx, client, req.Assignee)
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			issue.Fields.Assignee = userRef(ctx, client, user)
+		} else {
+			// An explicit null stops Jira from applying the project's default assignee
+			issue.Fields.Unknowns["assignee"] = nil
+		}
+	}
+
+	if req.Parent != "" {
+		issue.Fields.Parent = &jira.Parent{Key: req.Parent}
+	}
+	if req.Epic != "" {
+		if err := setEpic(ctx, client, issue.Fields, req.Epic); err != nil {
+			return nil, err
+		}
+	}
+
+	fields, err := getCreateMeta(ctx, client, req.Project, req.IssueType)
+	if err != nil {
+		// Without the create screen we can still create a plain issue and let Jira validate it. Fields from
+		// a template cannot be resolved either, except labels, which are plain strings.
+		if errors.Is(err, errCreateMetaUnavailable) && !givenFields {
+			for name, values := range req.Fields {
+				if strings.EqualFold(name, "labels") {
+					var labels listFlag
+					for _, value := range values {
+						labels.Set(value)
+					}
+					issue.Fields.Labels = labels
+				} else {
+					fmt.Fprintf(os.Stderr, "Warning: the create screen is not available, so the template's %q field was left out\n", name)
+				}
+			}
+			return issue, nil
+		}
+		return nil, err
+	}
+
+	values, err := resolveFields(ctx, client, fields, req.Fields, req.Project, req.IssueType)
+	if err != nil {
+		return nil, err
+	}
+	for id, value := range values {
+		issue.Fields.Unknowns[id] = value
+	}
+
+	isSet := func(id string) bool {
+		switch id {
+		case "description":
+			return description != ""
+		case "assignee":
+			return req.Assignee != ""
+		case "parent":
+			return issue.Fields.Parent != nil
+		}
+		_, ok := issue.Fields.Unknowns[id]
+		return ok
+	}
+
+	var missing []string
+	for _, field := range fields {
+		if field.Required && !field.HasDefaultValue && !isSet(field.ID) && !setByRequest[field.ID] {
+			missing = append(missing, fmt.Sprintf("%q", field.Name))
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing required fields for %s in %s: %s", req.IssueType, req.Project, strings.Join(missing, ", "))
+	}
+
+	return issue, nil
+}
+
+// resolveFields converts raw values keyed by field name or ID into values keyed by field ID, coerced to
+// each field's schema, checking that every field is on the create screen of the issue type in the project
+func resolveFields(ctx context.Context, client *jira.Client, fields []metaField, raw map[string][]string, projectKey, issueType string) (map[string]any, error) {
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := map[string]any{}
+	for _, name := range names {
+		field, ok := findMetaField(fields, name)
+		if !ok {
+			var available []string
+			for _, f := range fields {
+				if !setByRequest[f.ID] {
+					available = append(available, fmt.Sprintf("%q", f.Name))
+				}
+			}
+			return nil, fmt.Errorf("field %q is not on the create screen of %s in %s. Available fields: %s", name, issueType, projectKey, strings.Join(available, ", "))
+		}
+		value, err := fieldValue(ctx, client, *field, raw[name])
+		if err != nil {
+			return nil, err
+		}
+		values[field.ID] = value
+	}
+	return values, nil
+}
+
+// setEpic puts the issue in an epic: Cloud uses the parent field, Server/Data Center the "Epic Link" custom field
+func setEpic(ctx context.Context, client *jira.Client, fields *jira.IssueFields, epicKey string) error {
+	if isCloud(ctx, client) {
+		if fields.Parent != nil && fields.Parent.Key != epicKey {
+			return fmt.Errorf("an issue cannot have both parent %s and epic %s on Jira Cloud", fields.Parent.Key, epicKey)
+		}
+		fields.Parent = &jira.Parent{Key: epicKey}
+		return nil
+	}
+
+	fieldID, err := epicLinkFieldID(ctx, client)
+	if err != nil {
+		return err
+	}
+	if fields.Unknowns == nil {
+		fields.Unknowns = map[string]any{}
+	}
+	fields.Unknowns[fieldID] = epicKey
+	return nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// createMetaHandler serves the createmeta endpoints for project PROJ with a single Bug issue type
+func createMetaHandler(mux *http.ServeMux) {
+	mux.HandleFunc("/rest/api/2/issue/createmeta/PROJ/issuetypes", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"values": [{"id": "1", "name": "Bug"}, {"id": "2", "name": "Story"}], "total": 2, "isLast": true}`))
+	})
+	mux.HandleFunc("/rest/api/2/issue/createmeta/PROJ/issuetypes/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"values": [
+			{"fieldId": "summary", "name": "Summary", "required": true, "schema": {"type": "string"}},
+			{"fieldId": "issuetype", "name": "Issue Type", "required": true, "schema": {"type": "issuetype"}},
+			{"fieldId": "reporter", "name": "Reporter", "required": true, "schema": {"type": "user"}},
+			{"fieldId": "description", "name": "Description", "required": false, "schema": {"type": "string"}},
+			{"fieldId": "priority", "name": "Priority", "required": false, "hasDefaultValue": true, "schema": {"type": "priority"},
+				"allowedValues": [{"id": "1", "name": "High"}, {"id": "2", "name": "Low"}]},
+			{"fieldId": "labels", "name": "Labels", "required": false, "schema": {"type": "array", "items": "string"}},
+			{"fieldId": "customfield_10002", "name": "Story Points", "required": false, "schema": {"type": "number"}},
+			{"fieldId": "customfield_10003", "name": "Severity", "required": true, "schema": {"type": "option"},
+				"allowedValues": [{"id": "10", "value": "Critical"}, {"id": "11", "value": "Minor"}]}
+		], "total": 8, "isLast": true}`))
+	})
+}
+
+func TestBuildIssue(t *testing.T) {
+	stubTemplates(t)
+	mux := http.NewServeMux()
+	createMetaHandler(mux)
+	c := newTestClient(t, "Server", mux)
+
+	issue, err := buildIssue(context.Background(), c, issueRequest{
+		Project:   "PROJ",
+		IssueType: "bug",
+		Summary:   "Login broken",
+		Fields: map[string][]string{
+			"priority":     {"high"},
+			"Labels":       {"backend, login", "urgent"},
+			"Story Points": {"3"},
+			"Severity":     {"critical"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(issue.Fields.Unknowns)
+	if err != nil {
+		t.Fatalf("Failed to marshal fields: %v", err)
+	}
+	want := `{"customfield_10002":3,"customfield_10003":{"id":"10"},"labels":["backend","login","urgent"],"priority":{"id":"1"}}`
+	if string(data) != want {
+		t.Errorf("Expected fields %s, got %s", want, data)
+	}
+}
+
+func TestBuildIssue_Validation(t *testing.T) {
+	stubTemplates(t)
+	mux := http.NewServeMux()
+	createMetaHandler(mux)
+	c := newTestClient(t, "Server", mux)
+
+	tests := []struct {
+		name      string
+		issueType string
+		fields    map[string][]string
+		wantErr   string
+	}{
+		{"missing required field", "Bug", nil, `missing required fields for Bug in PROJ: "Severity"`},
+		{"unknown issue type", "Bgu", nil, `issue type "Bgu" not found in project PROJ. Available issue types: "Bug", "Story"`},
+		{"unknown field", "Bug", map[string][]string{"Colour": {"red"}}, `field "Colour" is not on the create screen`},
+		{"invalid option", "Bug", map[string][]string{"Severity": {"Major"}}, `invalid value "Major" for field "Severity". Allowed values: "Critical", "Minor"`},
+		{"invalid number", "Bug", map[string][]string{"Severity": {"Minor"}, "Story Points": {"three"}}, `field "Story Points" expects a number`},
+		{"multiple values", "Bug", map[string][]string{"Severity": {"Minor", "Critical"}}, `field "Severity" takes a single value`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := buildIssue(context.Background(), c, issueRequest{
+				Project:   "PROJ",
+				IssueType: tt.issueType,
+				Summary:   "Login broken",
+				Fields:    tt.fields,
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBuildIssue_TemplateWithoutCreateMeta(t *testing.T) {
+	stubTemplates(t, issueTemplate{Labels: []string{"triage, backend"}, Fields: map[string]any{"Severity": "Minor"}})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/createmeta/PROJ/issuetypes", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/rest/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	c := newTestClient(t, "Server", mux)
+
+	issue, err := buildIssue(context.Background(), c, issueRequest{Project: "PROJ", IssueType: "Bug", Summary: "Login broken"})
+	if err != nil {
+		t.Fatalf("Expected a plain issue without the create screen, got: %v", err)
+	}
+	if got := strings.Join(issue.Fields.Labels, ","); got != "triage,backend" {
+		t.Errorf("Expected the template's labels, got %q", got)
+	}
+	if len(issue.Fields.Unknowns) != 0 {
+		t.Errorf("Expected no other fields, got %v", issue.Fields.Unknowns)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// deleteIssue deletes an issue. go-jira sends deleteSubtasks in the request body, but Jira only reads it from the query.
+func deleteIssue(ctx context.Context, client *jira.Client, key string, withSubtasks bool) error {
+	req, err := client.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("rest/api/2/issue/%s?deleteSubtasks=%t", key, withSubtasks), nil)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return fmt.Errorf("failed to delete issue: %w", err)
+	}
+	return nil
+}
+
+// errHasSubtasks is returned when an issue with sub-tasks is to be deleted without them
+var errHasSubtasks = errors.New("the issue has sub-tasks, which would be deleted with it")
+
+// checkDeletable gets the issue to delete, refusing if it has sub-tasks that are not to be deleted with it
+func checkDeletable(ctx context.Context, client *jira.Client, key string, withSubtasks bool) (*jira.Issue, error) {
+	issue, _, err := client.Issue.GetWithContext(ctx, key, &jira.GetQueryOptions{Fields: "summary,status,subtasks"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	if len(issue.Fields.Subtasks) > 0 && !withSubtasks {
+		return nil, fmt.Errorf("%w (%s has %d)", errHasSubtasks, key, len(issue.Fields.Subtasks))
+	}
+	return issue, nil
+}
+
+// formatDeletion describes what is about to be deleted
+func formatDeletion(issue *jira.Issue) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-15s %-20s %s\n", issue.Key, issue.Fields.Status.Name, issue.Fields.Summary)
+	for _, subtask := range issue.Fields.Subtasks {
+		fmt.Fprintf(&sb, "  %-13s %-20s %s\n", subtask.Key, subtask.Fields.Status.Name, subtask.Fields.Summary)
+	}
+	return sb.String()
+}
+
+// confirmDeletion asks the user to type the issue key, so that a stray "y" cannot delete anything
+func confirmDeletion(p *prompter, key string) (bool, error) {
+	answer, err := p.ask(fmt.Sprintf("Type %s to delete it", key), "", false)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(answer, key), nil
+}
+
+// deleteIssueCommand deletes the current issue, after the user confirms it on a terminal or with --yes
+func deleteIssueCommand(ctx context.Context, withSubtasks, yes bool) error {
+	issue, err := checkDeletable(ctx, client, issueKey, withSubtasks)
+	if errors.Is(err, errHasSubtasks) {
+		return fmt.Errorf("%w: use --with-subtasks to delete them too", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !yes {
+		if !isInteractive() {
+			return fmt.Errorf("not deleting %s without confirmation: use --yes when not running in a terminal", issueKey)
+		}
+		fmt.Fprint(os.Stderr, formatDeletion(issue))
+		ok, err := confirmDeletion(newPrompter(os.Stdin, os.Stderr), issueKey)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("confirmation did not match, %s was not deleted", issueKey)
+		}
+	}
+
+	if err := deleteIssue(ctx, client, issueKey, withSubtasks); err != nil {
+		return err
+	}
+
+	if n := len(issue.Fields.Subtasks); n > 0 {
+		fmt.Printf("Deleted %s and %d sub-task(s)\n", issueKey, n)
+	} else {
+		fmt.Printf("Deleted %s\n", issueKey)
+	}
+	return nil
+}
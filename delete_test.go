@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDeleteIssue(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /rest/api/2/issue/PROJ-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key": "PROJ-1", "fields": {"summary": "Parent", "status": {"name": "To Do"},
+			"subtasks": [{"key": "PROJ-2", "fields": {"summary": "Child", "status": {"name": "Done"}}}]}}`))
+	})
+	var query string
+	mux.HandleFunc("DELETE /rest/api/2/issue/PROJ-1", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.WriteHeader(http.StatusNoContent)
+	})
+	c := newTestClient(t, "Server", mux)
+
+	if _, err := checkDeletable(context.Background(), c, "PROJ-1", false); !errors.Is(err, errHasSubtasks) {
+		t.Errorf("Expected errHasSubtasks, got: %v", err)
+	}
+
+	issue, err := checkDeletable(context.Background(), c, "PROJ-1", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := "PROJ-1          To Do                Parent\n  PROJ-2        Done                 Child\n"
+	if got := formatDeletion(issue); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	if err := deleteIssue(context.Background(), c, "PROJ-1", true); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if query != "deleteSubtasks=true" {
+		t.Errorf("Expected deleteSubtasks=true in the query, got %q", query)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// serverInfo is the subset of the serverInfo response we care about
+type serverInfo struct {
+	DeploymentType string `json:"deploymentType"`
+}
+
+// deploymentTypes caches the result of isCloud per client, as the MCP server reuses one client for its lifetime
+var deploymentTypes sync.Map
+
+// isCloud reports whether the client is talking to Jira Cloud rather than Server/Data Center.
+// Cloud identifies users by accountId while Server/Data Center uses usernames, so anything
+// that references a user needs to know which one it is talking to.
+func isCloud(ctx context.Context, client *jira.Client) bool {
+	if cloud, ok := deploymentTypes.Load(client); ok {
+		return cloud.(bool)
+	}
+
+	// Fall back to the host name if the server does not tell us
+	baseURL := client.GetBaseURL()
+	cloud := strings.HasSuffix(baseURL.Hostname(), ".atlassian.net")
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "rest/api/2/serverInfo", nil)
+	if err == nil {
+		info := &serverInfo{}
+		if _, err := client.Do(req, info); err == nil && info.DeploymentType != "" {
+			cloud = strings.EqualFold(info.DeploymentType, "Cloud")
+		}
+	}
+
+	deploymentTypes.Store(client, cloud)
+	return cloud
+}
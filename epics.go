@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// epicChildrenJQL returns JQL for the issues in an epic: Cloud puts them under the epic with the parent
+// field, Server/Data Center links them with the "Epic Link" custom field
+func epicChildrenJQL(ctx context.Context, client *jira.Client, epicKey string) (string, error) {
+	if isCloud(ctx, client) {
+		return fmt.Sprintf("parent = %s", epicKey), nil
+	}
+	fieldID, err := epicLinkFieldID(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("cf[%s] = %s", strings.TrimPrefix(fieldID, "customfield_"), epicKey), nil
+}
+
+// issueEpic returns the key of the epic an issue is in, or "" if it is in none
+func issueEpic(ctx context.Context, client *jira.Client, key string) (string, error) {
+	if isCloud(ctx, client) {
+		issue, _, err := client.Issue.GetWithContext(ctx, key, &jira.GetQueryOptions{Fields: "parent"})
+		if err != nil {
+			return "", fmt.Errorf("failed to get issue: %w", err)
+		}
+		if issue.Fields.Parent == nil {
+			return "", nil
+		}
+		return issue.Fields.Parent.Key, nil
+	}
+
+	fieldID, err := epicLinkFieldID(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	issue, _, err := client.Issue.GetWithContext(ctx, key, &jira.GetQueryOptions{Fields: fieldID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get issue: %w", err)
+	}
+	epic, _ := issue.Fields.Unknowns[fieldID].(string)
+	return epic, nil
+}
+
+// storyPointsFieldID returns the ID of the story points field, or "" if there is none
+func storyPointsFieldID(ctx context.Context, client *jira.Client) string {
+	id, _ := findFieldID(ctx, client, "story points", func(field jira.Field) bool {
+		return strings.EqualFold(field.Name, "Story Points") || strings.EqualFold(field.Name, "Story point estimate")
+	})
+	return id
+}
+
+// storyPoints returns the story points of an issue, if it has any
+func storyPoints(issue jira.Issue, fieldID string) (float64, bool) {
+	if fieldID == "" || issue.Fields == nil {
+		return 0, false
+	}
+	points, ok := issue.Fields.Unknowns[fieldID].(float64)
+	return points, ok
+}
+
+// epicProgress counts the issues of an epic, and their story points, by status category
+type epicProgress struct {
+	Done, InProgress, ToDo                   int
+	DonePoints, InProgressPoints, ToDoPoints float64
+	HasPoints                                bool
+}
+
+func summarizeEpic(issues []jira.Issue, pointsFieldID string) epicProgress {
+	var p epicProgress
+	for _, issue := range issues {
+		points, ok := storyPoints(issue, pointsFieldID)
+		p.HasPoints = p.HasPoints || ok
+		category := ""
+		if issue.Fields.Status != nil {
+			category = issue.Fields.Status.StatusCategory.Key
+		}
+		switch category {
+		case "done":
+			p.Done++
+			p.DonePoints += points
+		case "indeterminate":
+			p.InProgress++
+			p.InProgressPoints += points
+		default:
+			p.ToDo++
+			p.ToDoPoints += points
+		}
+	}
+	return p
+}
+
+// formatEpicIssues formats an epic's progress followed by its issues
+func formatEpicIssues(epic *jira.Issue, issues []jira.Issue, pointsFieldID string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s (%s)\n\n", epic.Key, epic.Fields.Summary, epic.Fields.Status.Name)
+
+	p := summarizeEpic(issues, pointsFieldID)
+	total := p.Done + p.InProgress + p.ToDo
+	percent := 0
+	if total > 0 {
+		percent = p.Done * 100 / total
+	}
+	fmt.Fprintf(&sb, "Issues:       %d done, %d in progress, %d to do (%d total, %d%% done)\n", p.Done, p.InProgress, p.ToDo, total, percent)
+	if p.HasPoints {
+		fmt.Fprintf(&sb, "Story points: %g done, %g in progress, %g to do (%g total)\n",
+			p.DonePoints, p.InProgressPoints, p.ToDoPoints, p.DonePoints+p.InProgressPoints+p.ToDoPoints)
+	}
+
+	if len(issues) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, issue := range issues {
+		points := ""
+		if v, ok := storyPoints(issue, pointsFieldID); ok {
+			points = fmt.Sprintf("%g", v)
+		}
+		fmt.Fprintf(&sb, "%-15s %-20s %-5s %s\n", issue.Key, issue.Fields.Status.Name, points, issue.Fields.Summary)
+	}
+	return sb.String()
+}
+
+// getEpicIssues returns an epic, the issues in it, and the ID of the story points field
+func getEpicIssues(ctx context.Context, client *jira.Client, epicKey string) (*jira.Issue, []jira.Issue, string, error) {
+	epic, _, err := client.Issue.GetWithContext(ctx, epicKey, &jira.GetQueryOptions{Fields: "summary,status,issuetype"})
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to get epic: %w", err)
+	}
+
+	jql, err := epicChildrenJQL(ctx, client, epic.Key)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	pointsFieldID := storyPointsFieldID(ctx, client)
+	fields := []string{"summary", "status", "issuetype"}
+	if pointsFieldID != "" {
+		fields = append(fields, pointsFieldID)
+	}
+	issues, err := searchIssues(ctx, client, jql+" ORDER BY rank ASC", &jira.SearchOptions{Fields: fields})
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return epic, issues, pointsFieldID, nil
+}
+
+// getEpics returns the epics of a project, only the ones not done unless all is set
+func getEpics(ctx context.Context, client *jira.Client, projectKey string, all bool) ([]jira.Issue, error) {
+	jql := fmt.Sprintf("project = %s AND issuetype = Epic", quoteJQL(projectKey))
+	if !all {
+		jql += " AND statusCategory != Done"
+	}
+	return searchIssues(ctx, client, jql+" ORDER BY rank ASC", &jira.SearchOptions{Fields: []string{"summary", "status"}})
+}
+
+// formatEpics formats epics as a table of key, status and summary
+func formatEpics(epics []jira.Issue) string {
+	var sb strings.Builder
+	for _, epic := range epics {
+		fmt.Fprintf(&sb, "%-15s %-20s %s\n", epic.Key, epic.Fields.Status.Name, epic.Fields.Summary)
+	}
+	return sb.String()
+}
+
+// addToEpic puts issues in an epic, moving them out of any epic they were in
+func addToEpic(ctx context.Context, client *jira.Client, epicKey string, keys []string) (string, error) {
+	var fields map[string]any
+	if isCloud(ctx, client) {
+		fields = map[string]any{"parent": map[string]any{"key": epicKey}}
+	} else {
+		fieldID, err := epicLinkFieldID(ctx, client)
+		if err != nil {
+			return "", err
+		}
+		fields = map[string]any{fieldID: epicKey}
+	}
+
+	for _, key := range keys {
+		if _, err := client.Issue.UpdateIssueWithContext(ctx, key, map[string]any{"fields": fields}); err != nil {
+			return "", fmt.Errorf("failed to add %s to %s: %w", key, epicKey, err)
+		}
+	}
+	return fmt.Sprintf("Added to %s: %s", epicKey, strings.Join(keys, ", ")), nil
+}
+
+// removeFromEpic takes issues out of an epic, leaving alone any that are not in it
+func removeFromEpic(ctx context.Context, client *jira.Client, epicKey string, keys []string) (string, error) {
+	var remove, skipped []string
+	for _, key := range keys {
+		epic, err := issueEpic(ctx, client, key)
+		if err != nil {
+			return "", err
+		}
+		if strings.EqualFold(epic, epicKey) {
+			remove = append(remove, key)
+		} else {
+			skipped = append(skipped, key)
+		}
+	}
+
+	var sb strings.Builder
+	if len(remove) > 0 {
+		// The agile API's "none" epic removes issues from their epic on both Cloud and Server/Data Center
+		req, err := client.NewRequestWithContext(ctx, "POST", "rest/agile/1.0/epic/none/issue", map[string]any{"issues": remove})
+		if err != nil {
+			return "", err
+		}
+		if _, err := client.Do(req, nil); err != nil {
+			return "", fmt.Errorf("failed to remove issues from %s: %w", epicKey, err)
+		}
+		fmt.Fprintf(&sb, "Removed from %s: %s\n", epicKey, strings.Join(remove, ", "))
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintf(&sb, "Not in %s: %s\n", epicKey, strings.Join(skipped, ", "))
+	}
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// editEpicIssues adds issues to or removes them from an epic, and describes what happened
+func editEpicIssues(ctx context.Context, client *jira.Client, action, epicKey string, keys []string) (string, error) {
+	if len(keys) == 0 {
+		return "", fmt.Errorf("no issues given")
+	}
+	switch action {
+	case "add":
+		return addToEpic(ctx, client, epicKey, keys)
+	case "remove":
+		return removeFromEpic(ctx, client, epicKey, keys)
+	}
+	return "", fmt.Errorf("unknown action %q: expected add or remove", action)
+}
+
+// listEpics lists the epics of a project
+func listEpics(ctx context.Context, projectKey string, all bool) error {
+	epics, err := getEpics(ctx, client, projectKey, all)
+	if err != nil {
+		return err
+	}
+
+	if len(epics) == 0 {
+		fmt.Printf("No epics found in %s\n", projectKey)
+		return nil
+	}
+	fmt.Print(formatEpics(epics))
+	return nil
+}
+
+// listEpicIssues prints the progress of an epic and the issues in it
+func listEpicIssues(ctx context.Context, epicKey string) error {
+	epic, issues, pointsFieldID, err := getEpicIssues(ctx, client, epicKey)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatEpicIssues(epic, issues, pointsFieldID))
+	return nil
+}
+
+// editEpic adds issues to or removes them from an epic
+func editEpic(ctx context.Context, action, epicKey string, keys []string) error {
+	message, err := editEpicIssues(ctx, client, action, epicKey, keys)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(message)
+	return nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// epicFieldsHandler serves the field list with the Epic Link and Story Points custom fields
+func epicFieldsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(`[
+		{"id": "customfield_10008", "name": "Epic Link", "custom": true, "schema": {"custom": "com.pyxis.greenhopper.jira:gh-epic-link"}},
+		{"id": "customfield_10002", "name": "Story Points", "custom": true, "schema": {"type": "number"}}
+	]`))
+}
+
+func TestEpicChildrenJQL(t *testing.T) {
+	for _, tt := range []struct {
+		deploymentType string
+		want           string
+	}{
+		{"Cloud", "parent = PROJ-100"},
+		{"Server", "cf[10008] = PROJ-100"},
+	} {
+		t.Run(tt.deploymentType, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rest/api/2/field", epicFieldsHandler)
+			c := newTestClient(t, tt.deploymentType, mux)
+
+			got, err := epicChildrenJQL(context.Background(), c, "PROJ-100")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatEpicIssues(t *testing.T) {
+	issue := func(key, category string, points any) jira.Issue {
+		i := testIssue(key, "Story", "Summary of "+key)
+		i.Fields.Status = &jira.Status{Name: category, StatusCategory: jira.StatusCategory{Key: category}}
+		if points != nil {
+			i.Fields.Unknowns = map[string]any{"customfield_10002": points}
+		}
+		return i
+	}
+	epic := testIssue("PROJ-100", "Epic", "Search")
+	epic.Fields.Status = &jira.Status{Name: "In Progress"}
+	issues := []jira.Issue{
+		issue("PROJ-1", "done", 3.0),
+		issue("PROJ-2", "done", 2.0),
+		issue("PROJ-3", "indeterminate", 5.0),
+		issue("PROJ-4", "new", nil),
+	}
+
+	got := formatEpicIssues(&epic, issues, "customfield_10002")
+	for _, want := range []string{
+		"PROJ-100 Search (In Progress)",
+		"Issues:       2 done, 1 in progress, 1 to do (4 total, 50% done)",
+		"Story points: 5 done, 5 in progress, 0 to do (10 total)",
+		"PROJ-3          indeterminate        5     Summary of PROJ-3",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+
+	if got := formatEpicIssues(&epic, issues, ""); strings.Contains(got, "Story points") {
+		t.Errorf("Expected no story points without a story points field, got:\n%s", got)
+	}
+}
+
+func TestAddToEpic(t *testing.T) {
+	for _, tt := range []struct {
+		deploymentType string
+		want           string
+	}{
+		{"Cloud", `{"fields":{"parent":{"key":"PROJ-100"}}}`},
+		{"Server", `{"fields":{"customfield_10008":"PROJ-100"}}`},
+	} {
+		t.Run(tt.deploymentType, func(t *testing.T) {
+			var bodies []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rest/api/2/field", epicFieldsHandler)
+			mux.HandleFunc("PUT /rest/api/2/issue/{key}", func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				json.NewDecoder(r.Body).Decode(&body)
+				b, _ := json.Marshal(body)
+				bodies = append(bodies, r.PathValue("key")+" "+string(b))
+				w.WriteHeader(http.StatusNoContent)
+			})
+			c := newTestClient(t, tt.deploymentType, mux)
+
+			if _, err := addToEpic(context.Background(), c, "PROJ-100", []string{"PROJ-1", "PROJ-2"}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(bodies) != 2 || bodies[0] != "PROJ-1 "+tt.want || bodies[1] != "PROJ-2 "+tt.want {
+				t.Errorf("Expected both issues updated with %s, got %v", tt.want, bodies)
+			}
+		})
+	}
+}
+
+func TestRemoveFromEpic(t *testing.T) {
+	var removed []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/field", epicFieldsHandler)
+	mux.HandleFunc("GET /rest/api/2/issue/{key}", func(w http.ResponseWriter, r *http.Request) {
+		epic := map[string]string{"PROJ-1": "PROJ-100", "PROJ-2": "PROJ-200"}[r.PathValue("key")]
+		json.NewEncoder(w).Encode(map[string]any{"key": r.PathValue("key"), "fields": map[string]any{"customfield_10008": epic}})
+	})
+	mux.HandleFunc("POST /rest/agile/1.0/epic/none/issue", func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Issues []string }
+		json.NewDecoder(r.Body).Decode(&body)
+		removed = body.Issues
+		w.WriteHeader(http.StatusNoContent)
+	})
+	c := newTestClient(t, "Server", mux)
+
+	message, err := removeFromEpic(context.Background(), c, "PROJ-100", []string{"PROJ-1", "PROJ-2"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(removed) != 1 || removed[0] != "PROJ-1" {
+		t.Errorf("Expected only PROJ-1 removed, got %v", removed)
+	}
+	if want := "Removed from PROJ-100: PROJ-1\nNot in PROJ-100: PROJ-2"; message != want {
+		t.Errorf("Expected %q, got %q", want, message)
+	}
+}
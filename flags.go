@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// newFlagSet returns a flag set for a sub-command that reports errors to the caller instead of exiting
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// parseFlags parses flags that may appear anywhere among the positional arguments,
+// e.g. "clone-issue PROJ-1 --project OTHER", and returns the positional arguments. Everything
+// after "--" is positional, e.g. a description starting with "-".
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if n := len(args) - len(rest); n > 0 && args[n-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// fieldsFlag collects repeated "name=value" flags, e.g. --field "Story Points=3" --field Labels=a,b
+type fieldsFlag map[string][]string
+
+func (f fieldsFlag) String() string {
+	var pairs []string
+	for name, values := range f {
+		for _, value := range values {
+			pairs = append(pairs, name+"="+value)
+		}
+	}
+	return strings.Join(pairs, ", ")
+}
+
+func (f fieldsFlag) Set(s string) error {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok || strings.TrimSpace(name) == "" {
+		return fmt.Errorf("expected name=value, got %q", s)
+	}
+	name = strings.TrimSpace(name)
+	f[name] = append(f[name], value)
+	return nil
+}
+
+// listFlag collects repeated flags, each of which may also be a comma-separated list, e.g. --field status,assignee
+type listFlag []string
+
+func (f *listFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *listFlag) Set(s string) error {
+	for _, value := range strings.Split(s, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			*f = append(*f, value)
+		}
+	}
+	return nil
+}
+
+// fieldStrings converts structured field values, such as those from JSON or YAML, into the raw strings
+// buildIssue expects; each value is a scalar or a list of scalars
+func fieldStrings(object map[string]any) map[string][]string {
+	fields := map[string][]string{}
+	for name, value := range object {
+		switch v := value.(type) {
+		case []any:
+			for _, item := range v {
+				fields[name] = append(fields[name], fmt.Sprint(item))
+			}
+		default:
+			fields[name] = []string{fmt.Sprint(v)}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFlags(t *testing.T) {
+	tests := []struct {
+		args       []string
+		positional []string
+		project    string
+	}{
+		{[]string{"PROJ-1", "--project", "OTHER"}, []string{"PROJ-1"}, "OTHER"},
+		{[]string{"--project", "OTHER", "PROJ-1", "PROJ-2"}, []string{"PROJ-1", "PROJ-2"}, "OTHER"},
+		{[]string{"--", "PROJ", "Task", "- fix x"}, []string{"PROJ", "Task", "- fix x"}, ""},
+		{[]string{"--project", "OTHER", "PROJ-1", "--", "-1", "--project"}, []string{"PROJ-1", "-1", "--project"}, "OTHER"},
+	}
+	for _, tt := range tests {
+		var project string
+		fs := newFlagSet("test")
+		fs.StringVar(&project, "project", "", "")
+		positional, err := parseFlags(fs, tt.args)
+		if err != nil {
+			t.Errorf("parseFlags(%q) failed: %v", tt.args, err)
+			continue
+		}
+		if !reflect.DeepEqual(positional, tt.positional) || project != tt.project {
+			t.Errorf("parseFlags(%q) = %q with project %q, want %q with project %q", tt.args, positional, project, tt.positional, tt.project)
+		}
+	}
+
+	fs := newFlagSet("test")
+	if _, err := parseFlags(fs, []string{"PROJ-1", "-x"}); err == nil {
+		t.Error("Expected an error for an unknown flag before --")
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// maxForecastDays caps a simulated run, so a throughput of mostly empty days cannot run on for ever
+const maxForecastDays = 10 * 365
+
+// parseDays parses a period such as "90d" or "12w" as a number of days
+func parseDays(period string) (int, error) {
+	s, unit := period, 1
+	switch {
+	case strings.HasSuffix(s, "d"):
+		s = strings.TrimSuffix(s, "d")
+	case strings.HasSuffix(s, "w"):
+		s, unit = strings.TrimSuffix(s, "w"), 7
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid period %q: expected a number of days or weeks, e.g. 90d or 12w", period)
+	}
+	return n * unit, nil
+}
+
+// dailyThroughput counts the issues resolved on each of the days before today, oldest first, including
+// the days when none were
+func dailyThroughput(resolved []time.Time, days int, today time.Time) []int {
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	first := today.AddDate(0, 0, -days)
+	counts := make([]int, days)
+	for _, t := range resolved {
+		t = t.In(today.Location())
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, today.Location())
+		if i := int(math.Round(day.Sub(first).Hours() / 24)); i >= 0 && i < days {
+			counts[i]++
+		}
+	}
+	return counts
+}
+
+// simulateCompletion runs a Monte Carlo simulation of finishing the remaining issues, each simulated day
+// resolving as many issues as a day picked at random from the throughput history, and returns the
+// number of days each run took, sorted
+func simulateCompletion(remaining int, throughput []int, runs int, rng *rand.Rand) []int {
+	results := make([]int, runs)
+	for run := range results {
+		left, day := remaining, 0
+		for left > 0 && day < maxForecastDays {
+			day++
+			left -= throughput[rng.IntN(len(throughput))]
+		}
+		results[run] = day
+	}
+	sort.Ints(results)
+	return results
+}
+
+// deliveryForecast is the outcome of a forecast: how many days the remaining work took in half, 85% and
+// 95% of the simulated runs
+type deliveryForecast struct {
+	Remaining   int
+	Resolved    int
+	HistoryDays int
+	Runs        int
+	P50         int
+	P85         int
+	P95         int
+}
+
+// forecastDelivery simulates finishing the remaining issues at the throughput of the resolved ones
+func forecastDelivery(remaining int, resolved []time.Time, historyDays, runs int, today time.Time, rng *rand.Rand) (*deliveryForecast, error) {
+	throughput := dailyThroughput(resolved, historyDays, today)
+	total := 0
+	for _, n := range throughput {
+		total += n
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("no issues were resolved in the last %d days, so there is no throughput to forecast from", historyDays)
+	}
+	f := &deliveryForecast{Remaining: remaining, Resolved: total, HistoryDays: historyDays, Runs: runs}
+	if remaining == 0 {
+		return f, nil
+	}
+
+	days := simulateCompletion(remaining, throughput, runs, rng)
+	f.P50 = days[percentileIndex(50, runs)]
+	f.P85 = days[percentileIndex(85, runs)]
+	f.P95 = days[percentileIndex(95, runs)]
+	return f, nil
+}
+
+// formatForecast formats the remaining work, the throughput it was forecast from, and the dates by
+// which it is done with 50%, 85% and 95% likelihood
+func formatForecast(f *deliveryForecast, today time.Time) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Remaining:  %d issue(s)\n", f.Remaining)
+	fmt.Fprintf(&sb, "Throughput: %d issue(s) resolved in the last %d days (%.2f a day)\n", f.Resolved, f.HistoryDays, float64(f.Resolved)/float64(f.HistoryDays))
+	if f.Remaining == 0 {
+		sb.WriteString("\nNothing left to forecast\n")
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, "\nLikely done by, from %d simulated runs:\n", f.Runs)
+	for _, p := range []struct {
+		name string
+		days int
+	}{{"50%", f.P50}, {"85%", f.P85}, {"95%", f.P95}} {
+		date := today.AddDate(0, 0, p.days).Format(time.DateOnly)
+		if p.days >= maxForecastDays {
+			date = "not within 10 years"
+		}
+		fmt.Fprintf(&sb, "  %s  %s  (%d days)\n", p.name, date, p.days)
+	}
+	return sb.String()
+}
+
+// printForecast forecasts when the unresolved issues matching JQL will be done, from the issues
+// resolved in the history window: those matching throughputJQL, or by default those of the same projects
+func printForecast(ctx context.Context, jql string, historyDays, runs int, throughputJQL string) error {
+	issues, err := searchIssues(ctx, client, jql, &jira.SearchOptions{Fields: []string{"status", "project"}})
+	if err != nil {
+		return err
+	}
+	remaining := 0
+	var projects []string
+	for _, issue := range issues {
+		if key := issue.Fields.Project.Key; key != "" && !containsFold(projects, key) {
+			projects = append(projects, key)
+		}
+		if issue.Fields.Status == nil || issue.Fields.Status.StatusCategory.Key != "done" {
+			remaining++
+		}
+	}
+
+	if throughputJQL == "" {
+		if len(projects) == 0 {
+			return fmt.Errorf("no issues found, so no projects to take the throughput from; use --throughput-jql")
+		}
+		var quoted []string
+		for _, project := range projects {
+			quoted = append(quoted, quoteJQL(project))
+		}
+		throughputJQL = fmt.Sprintf("project in (%s)", strings.Join(quoted, ", "))
+	}
+	// The order does not matter, and an ORDER BY clause cannot be followed by more conditions
+	resolvedJQL := fmt.Sprintf("resolved >= -%dd", historyDays)
+	if filter := stripOrderBy(throughputJQL); filter != "" {
+		resolvedJQL = fmt.Sprintf("(%s) AND %s", filter, resolvedJQL)
+	}
+	resolvedIssues, err := searchIssues(ctx, client, resolvedJQL, &jira.SearchOptions{Fields: []string{"resolutiondate"}})
+	if err != nil {
+		return err
+	}
+	var resolved []time.Time
+	for _, issue := range resolvedIssues {
+		if t := time.Time(issue.Fields.Resolutiondate); !t.IsZero() {
+			resolved = append(resolved, t)
+		}
+	}
+
+	today := time.Now()
+	f, err := forecastDelivery(remaining, resolved, historyDays, runs, today, rand.New(rand.NewPCG(uint64(today.UnixNano()), 0)))
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatForecast(f, today))
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		period  string
+		want    int
+		wantErr bool
+	}{
+		{"90d", 90, false},
+		{"12w", 84, false},
+		{"30", 30, false},
+		{"0d", 0, true},
+		{"3m", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseDays(tt.period)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseDays(%q) = %d, %v; want %d", tt.period, got, err, tt.want)
+		}
+	}
+}
+
+func TestDailyThroughput(t *testing.T) {
+	today := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+	resolved := []time.Time{
+		time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 17, 18, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), // today, not a whole day yet
+		time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),  // before the window
+	}
+	if got, want := dailyThroughput(resolved, 4, today), []int{0, 1, 0, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestForecastDelivery(t *testing.T) {
+	today := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+	var resolved []time.Time
+	for day := 1; day <= 10; day++ {
+		// Two issues resolved on every other day: a throughput of one a day
+		if day%2 == 0 {
+			at := today.AddDate(0, 0, -day)
+			resolved = append(resolved, at, at)
+		}
+	}
+
+	f, err := forecastDelivery(10, resolved, 10, 1000, today, rand.New(rand.NewPCG(1, 2)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if f.Resolved != 10 || f.P50 < 8 || f.P50 > 12 || f.P50 > f.P85 || f.P85 > f.P95 {
+		t.Errorf("Unexpected forecast: %+v", f)
+	}
+
+	got := formatForecast(f, today)
+	want := "  50%  " + today.AddDate(0, 0, f.P50).Format(time.DateOnly)
+	if !strings.Contains(got, "Throughput: 10 issue(s) resolved in the last 10 days (1.00 a day)") || !strings.Contains(got, want) {
+		t.Errorf("Unexpected output:\n%s", got)
+	}
+
+	if _, err := forecastDelivery(10, nil, 10, 1000, today, rand.New(rand.NewPCG(1, 2))); err == nil {
+		t.Error("Expected an error without any throughput")
+	}
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// issueKeyPattern matches an issue key, to tell it apart from JQL
+var issueKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-\d+$`)
+
+// graphNode is an issue in a dependency graph
+type graphNode struct {
+	Key            string
+	Type           string
+	Summary        string
+	Status         string
+	StatusCategory string
+}
+
+// graphEdge is a link between two issues, in its outward direction (e.g. "PROJ-1 blocks PROJ-2"), or a
+// parent/child relation from the parent to the child
+type graphEdge struct {
+	From, To string
+	Label    string
+	Blocks   bool
+	Parent   bool
+}
+
+// issueGraph is the issues reached from a starting point by links and parent/child relations
+type issueGraph struct {
+	Nodes []*graphNode
+	Edges []graphEdge
+}
+
+// buildGraph walks links and parent/child relations out from an issue key, or the issues matching JQL, to
+// the given depth. Edges are kept only between issues in the graph.
+func buildGraph(ctx context.Context, client *jira.Client, start string, depth int) (*issueGraph, error) {
+	fields := []string{"summary", "status", "issuetype", "issuelinks", "parent", "subtasks"}
+	epicFieldID := ""
+	if !isCloud(ctx, client) {
+		// Without Jira Software there is no Epic Link field, and so no epics to follow
+		epicFieldID, _ = epicLinkFieldID(ctx, client)
+		if epicFieldID != "" {
+			fields = append(fields, epicFieldID)
+		}
+	}
+
+	jql := start
+	if issueKeyPattern.MatchString(start) {
+		jql = fmt.Sprintf("key = %s", start)
+	}
+	options := &jira.SearchOptions{Fields: fields}
+
+	g := &issueGraph{}
+	nodes := map[string]*graphNode{}
+	queued := map[string]bool{}
+	seenEdges := map[graphEdge]bool{}
+	var edges []graphEdge
+	for level := 0; ; level++ {
+		issues, err := searchIssues(ctx, client, jql, options)
+		if err != nil {
+			return nil, err
+		}
+		if level == 0 && len(issues) == 0 {
+			return nil, fmt.Errorf("no issues found for %q", start)
+		}
+
+		var next []string
+		follow := func(edge graphEdge, other string) {
+			if !seenEdges[edge] {
+				seenEdges[edge] = true
+				edges = append(edges, edge)
+			}
+			if level < depth && nodes[other] == nil && !queued[other] {
+				queued[other] = true
+				next = append(next, other)
+			}
+		}
+
+		for _, issue := range issues {
+			if nodes[issue.Key] != nil {
+				continue
+			}
+			node := &graphNode{Key: issue.Key, Type: issue.Fields.Type.Name, Summary: issue.Fields.Summary}
+			if issue.Fields.Status != nil {
+				node.Status = issue.Fields.Status.Name
+				node.StatusCategory = issue.Fields.Status.StatusCategory.Key
+			}
+			nodes[issue.Key] = node
+			g.Nodes = append(g.Nodes, node)
+		}
+
+		for _, issue := range issues {
+			f := issue.Fields
+			for _, link := range f.IssueLinks {
+				edge := graphEdge{Label: link.Type.Outward, Blocks: isBlocksLink(link.Type)}
+				other := ""
+				if link.OutwardIssue != nil {
+					edge.From, edge.To, other = issue.Key, link.OutwardIssue.Key, link.OutwardIssue.Key
+				} else if link.InwardIssue != nil {
+					edge.From, edge.To, other = link.InwardIssue.Key, issue.Key, link.InwardIssue.Key
+				} else {
+					continue
+				}
+				follow(edge, other)
+			}
+			if f.Parent != nil {
+				follow(graphEdge{From: f.Parent.Key, To: issue.Key, Parent: true}, f.Parent.Key)
+			}
+			for _, subtask := range f.Subtasks {
+				follow(graphEdge{From: issue.Key, To: subtask.Key, Parent: true}, subtask.Key)
+			}
+			if epic, _ := f.Unknowns[epicFieldID].(string); epicFieldID != "" && epic != "" {
+				follow(graphEdge{From: epic, To: issue.Key, Parent: true}, epic)
+			}
+			if strings.EqualFold(f.Type.Name, "Epic") && level < depth {
+				childJQL, err := epicChildrenJQL(ctx, client, issue.Key)
+				if err != nil {
+					return nil, err
+				}
+				children, err := searchIssues(ctx, client, childJQL, &jira.SearchOptions{Fields: []string{"key"}})
+				if err != nil {
+					return nil, err
+				}
+				for _, child := range children {
+					follow(graphEdge{From: issue.Key, To: child.Key, Parent: true}, child.Key)
+				}
+			}
+		}
+
+		if len(next) == 0 {
+			break
+		}
+		// Linked issues can be in projects the user cannot see, which strict validation would reject
+		jql = fmt.Sprintf("key in (%s)", strings.Join(next, ", "))
+		options = &jira.SearchOptions{Fields: fields, ValidateQuery: "warn"}
+	}
+
+	for _, edge := range edges {
+		if nodes[edge.From] != nil && nodes[edge.To] != nil {
+			g.Edges = append(g.Edges, edge)
+		}
+	}
+	return g, nil
+}
+
+// blocked returns the issues blocked by an issue that is not done
+func (g *issueGraph) blocked() map[string]bool {
+	category := map[string]string{}
+	for _, node := range g.Nodes {
+		category[node.Key] = node.StatusCategory
+	}
+	blocked := map[string]bool{}
+	for _, edge := range g.Edges {
+		if edge.Blocks && category[edge.From] != "done" {
+			blocked[edge.To] = true
+		}
+	}
+	return blocked
+}
+
+// cycles returns the groups of issues that block each other in a cycle, found as the strongly connected
+// components of the blocking links (Tarjan's algorithm)
+func (g *issueGraph) cycles() [][]string {
+	next := map[string][]string{}
+	for _, edge := range g.Edges {
+		if edge.Blocks {
+			next[edge.From] = append(next[edge.From], edge.To)
+		}
+	}
+
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var cycles [][]string
+	var visit func(key string)
+	visit = func(key string) {
+		index[key] = len(index)
+		low[key] = index[key]
+		stack = append(stack, key)
+		onStack[key] = true
+		selfLoop := false
+		for _, to := range next[key] {
+			if to == key {
+				selfLoop = true
+			}
+			if _, ok := index[to]; !ok {
+				visit(to)
+				low[key] = min(low[key], low[to])
+			} else if onStack[to] {
+				low[key] = min(low[key], index[to])
+			}
+		}
+		if low[key] != index[key] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == key {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+	for _, node := range g.Nodes {
+		if _, ok := index[node.Key]; !ok {
+			visit(node.Key)
+		}
+	}
+	return cycles
+}
+
+// cycleEdges returns the blocking links between issues in the same cycle
+func (g *issueGraph) cycleEdges() map[int]bool {
+	component := map[string]int{}
+	for i, cycle := range g.cycles() {
+		for _, key := range cycle {
+			component[key] = i + 1
+		}
+	}
+	edges := map[int]bool{}
+	for i, edge := range g.Edges {
+		if edge.Blocks && component[edge.From] != 0 && component[edge.From] == component[edge.To] {
+			edges[i] = true
+		}
+	}
+	return edges
+}
+
+// formatDOT formats a graph for Graphviz. Blocked issues are filled red and links in cycles drawn in bold red.
+func formatDOT(g *issueGraph, name string) string {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+	}
+	blocked := g.blocked()
+	inCycle := g.cycleEdges()
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph %s {\n", quote(name))
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=rounded];\n")
+	for _, node := range g.Nodes {
+		attrs := "label=" + quote(fmt.Sprintf("%s %s\n%s\n(%s)", node.Type, node.Key, node.Summary, node.Status))
+		if blocked[node.Key] {
+			attrs += `, style="rounded,filled", fillcolor="#f8d7da", color="#c0392b"`
+		} else if node.StatusCategory == "done" {
+			attrs += `, style="rounded,filled", fillcolor="#d4edda"`
+		}
+		fmt.Fprintf(&sb, "  %s [%s];\n", quote(node.Key), attrs)
+	}
+	for i, edge := range g.Edges {
+		var attrs []string
+		if edge.Parent {
+			attrs = append(attrs, "style=dashed", "arrowhead=none")
+		} else {
+			attrs = append(attrs, "label="+quote(edge.Label))
+		}
+		if inCycle[i] {
+			attrs = append(attrs, `color="#c0392b"`, "penwidth=2")
+		}
+		fmt.Fprintf(&sb, "  %s -> %s [%s];\n", quote(edge.From), quote(edge.To), strings.Join(attrs, ", "))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// formatMermaid formats a graph as a Mermaid flowchart, with the same highlighting as formatDOT
+func formatMermaid(g *issueGraph) string {
+	id := func(key string) string {
+		return strings.ReplaceAll(key, "-", "_")
+	}
+	text := func(s string) string {
+		return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;").Replace(s)
+	}
+	blocked := g.blocked()
+	inCycle := g.cycleEdges()
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	var blockedIDs, doneIDs []string
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&sb, "  %s[\"%s %s<br/>%s<br/>(%s)\"]\n", id(node.Key), text(node.Type), node.Key, text(node.Summary), text(node.Status))
+		if blocked[node.Key] {
+			blockedIDs = append(blockedIDs, id(node.Key))
+		} else if node.StatusCategory == "done" {
+			doneIDs = append(doneIDs, id(node.Key))
+		}
+	}
+	var cycleLinks []string
+	for i, edge := range g.Edges {
+		if edge.Parent {
+			fmt.Fprintf(&sb, "  %s -.- %s\n", id(edge.From), id(edge.To))
+		} else {
+			fmt.Fprintf(&sb, "  %s -->|%s| %s\n", id(edge.From), text(edge.Label), id(edge.To))
+		}
+		if inCycle[i] {
+			cycleLinks = append(cycleLinks, fmt.Sprint(i))
+		}
+	}
+	if len(blockedIDs) > 0 {
+		sb.WriteString("  classDef blocked fill:#f8d7da,stroke:#c0392b\n")
+		fmt.Fprintf(&sb, "  class %s blocked\n", strings.Join(blockedIDs, ","))
+	}
+	if len(doneIDs) > 0 {
+		sb.WriteString("  classDef done fill:#d4edda\n")
+		fmt.Fprintf(&sb, "  class %s done\n", strings.Join(doneIDs, ","))
+	}
+	if len(cycleLinks) > 0 {
+		fmt.Fprintf(&sb, "  linkStyle %s stroke:#c0392b,stroke-width:3px\n", strings.Join(cycleLinks, ","))
+	}
+	return sb.String()
+}
+
+// printGraph prints the graph reached from an issue key or JQL, and warns about blocking cycles
+func printGraph(ctx context.Context, start string, depth int, format string) error {
+	g, err := buildGraph(ctx, client, start, depth)
+	if err != nil {
+		return err
+	}
+
+	if format == "mermaid" {
+		fmt.Print(formatMermaid(g))
+	} else {
+		fmt.Print(formatDOT(g, start))
+	}
+	for _, cycle := range g.cycles() {
+		fmt.Fprintf(os.Stderr, "Warning: issues block each other in a cycle: %s\n", strings.Join(cycle, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuildGraph(t *testing.T) {
+	blocks := map[string]any{"name": "Blocks", "outward": "blocks", "inward": "is blocked by"}
+	issues := map[string]map[string]any{
+		"PROJ-1": {"key": "PROJ-1", "fields": map[string]any{
+			"summary": "First", "status": map[string]any{"name": "In Progress", "statusCategory": map[string]any{"key": "indeterminate"}},
+			"issuetype":  map[string]any{"name": "Story"},
+			"issuelinks": []any{map[string]any{"type": blocks, "outwardIssue": map[string]any{"key": "PROJ-2"}}},
+		}},
+		"PROJ-2": {"key": "PROJ-2", "fields": map[string]any{
+			"summary": "Second", "status": map[string]any{"name": "To Do", "statusCategory": map[string]any{"key": "new"}},
+			"issuetype": map[string]any{"name": "Story"},
+			"issuelinks": []any{
+				map[string]any{"type": blocks, "inwardIssue": map[string]any{"key": "PROJ-1"}},
+				map[string]any{"type": blocks, "outwardIssue": map[string]any{"key": "PROJ-3"}},
+			},
+		}},
+		"PROJ-3": {"key": "PROJ-3", "fields": map[string]any{
+			"summary": "Third", "status": map[string]any{"name": "To Do", "statusCategory": map[string]any{"key": "new"}},
+			"issuetype":  map[string]any{"name": "Story"},
+			"issuelinks": []any{map[string]any{"type": blocks, "outwardIssue": map[string]any{"key": "PROJ-2"}}},
+			"subtasks":   []any{map[string]any{"key": "PROJ-4"}},
+		}},
+		"PROJ-4": {"key": "PROJ-4", "fields": map[string]any{
+			"summary": "Fourth", "status": map[string]any{"name": "To Do"}, "issuetype": map[string]any{"name": "Sub-task", "subtask": true},
+		}},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		jql := r.URL.Query().Get("jql")
+		var found []map[string]any
+		for _, key := range strings.FieldsFunc(jql, func(r rune) bool { return strings.ContainsRune(" ,()=", r) }) {
+			if issue, ok := issues[key]; ok {
+				found = append(found, issue)
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"total": len(found), "issues": found})
+	})
+	c := newTestClient(t, "Cloud", mux)
+
+	g, err := buildGraph(context.Background(), c, "PROJ-1", 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var keys []string
+	for _, node := range g.Nodes {
+		keys = append(keys, node.Key)
+	}
+	if want := []string{"PROJ-1", "PROJ-2", "PROJ-3"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Expected nodes %v, got %v (PROJ-4 is beyond the depth)", want, keys)
+	}
+	if len(g.Edges) != 3 {
+		t.Errorf("Expected 3 blocking edges, got %+v", g.Edges)
+	}
+	if got := g.blocked(); !got["PROJ-2"] || !got["PROJ-3"] || got["PROJ-1"] {
+		t.Errorf("Expected PROJ-2 and PROJ-3 blocked, got %v", got)
+	}
+	if got, want := g.cycles(), [][]string{{"PROJ-2", "PROJ-3"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected cycles %v, got %v", want, got)
+	}
+
+	dot := formatDOT(g, "PROJ-1")
+	for _, want := range []string{
+		`digraph "PROJ-1" {`,
+		`"PROJ-1" -> "PROJ-2" [label="blocks"];`,
+		`"PROJ-2" -> "PROJ-3" [label="blocks", color="#c0392b", penwidth=2];`,
+		`"PROJ-2" [label="Story PROJ-2\nSecond\n(To Do)", style="rounded,filled", fillcolor="#f8d7da", color="#c0392b"];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("Expected DOT to contain %s, got:\n%s", want, dot)
+		}
+	}
+
+	mermaid := formatMermaid(g)
+	for _, want := range []string{
+		"flowchart LR",
+		"PROJ_1 -->|blocks| PROJ_2",
+		"class PROJ_2,PROJ_3 blocked",
+		"linkStyle 1,2 stroke:#c0392b,stroke-width:3px",
+	} {
+		if !strings.Contains(mermaid, want) {
+			t.Errorf("Expected Mermaid to contain %s, got:\n%s", want, mermaid)
+		}
+	}
+}
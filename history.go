@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// jiraTimeLayout is the layout of timestamps in Jira's REST API, e.g. "2026-10-12T14:03:27.000+0000"
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// changelogCap is the most histories Jira Cloud returns in a changelog expanded in an issue or search result
+const changelogCap = 100
+
+// completeChangelogs fetches the whole changelog of each issue whose expanded changelog may have been cut
+// short. Only Jira Cloud caps it, and go-jira drops the changelog's total, so an issue with as many
+// histories as the cap is taken to have more. If the whole changelog cannot be fetched, a warning says
+// the results for the issue may be wrong.
+func completeChangelogs(ctx context.Context, client *jira.Client, issues []jira.Issue) error {
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Changelog == nil || len(issue.Changelog.Histories) < changelogCap || !isCloud(ctx, client) {
+			continue
+		}
+		histories, err := getChangelog(ctx, client, issue.Key)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: the changelog of %s was cut short at %d changes: %v\n", issue.Key, len(issue.Changelog.Histories), err)
+			continue
+		}
+		issue.Changelog.Histories = histories
+	}
+	return nil
+}
+
+// getChangelog returns the whole changelog of an issue, a page at a time
+func getChangelog(ctx context.Context, client *jira.Client, key string) ([]jira.ChangelogHistory, error) {
+	var all []jira.ChangelogHistory
+	for {
+		req, err := client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("rest/api/2/issue/%s/changelog?startAt=%d", key, len(all)), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Total  int                     `json:"total"`
+			IsLast bool                    `json:"isLast"`
+			Values []jira.ChangelogHistory `json:"values"`
+		}
+		if _, err := client.Do(req, &page); err != nil {
+			return nil, fmt.Errorf("failed to get changelog: %w", err)
+		}
+		all = append(all, page.Values...)
+		if page.IsLast || len(page.Values) == 0 || len(all) >= page.Total {
+			return all, nil
+		}
+	}
+}
+
+// historyEntry is the change of one field of an issue
+type historyEntry struct {
+	Created time.Time
+	Author  string
+	Field   string
+	From    string
+	To      string
+}
+
+// getHistory returns the field changes of an issue, oldest first, only those of the given fields if any
+// are given (matched by name, ignoring case)
+func getHistory(ctx context.Context, client *jira.Client, key string, fields []string) ([]historyEntry, error) {
+	issue, _, err := client.Issue.GetWithContext(ctx, key, &jira.GetQueryOptions{Expand: "changelog", Fields: "summary"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue with changelog: %w", err)
+	}
+	if issue.Changelog == nil {
+		return nil, nil
+	}
+	if err := completeChangelogs(ctx, client, []jira.Issue{*issue}); err != nil {
+		return nil, err
+	}
+
+	var entries []historyEntry
+	for _, history := range issue.Changelog.Histories {
+		created, err := time.Parse(jiraTimeLayout, history.Created)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected time %q in changelog: %w", history.Created, err)
+		}
+		for _, item := range history.Items {
+			if len(fields) > 0 && !containsFold(fields, item.Field) {
+				continue
+			}
+			entries = append(entries, historyEntry{
+				Created: created,
+				Author:  history.Author.DisplayName,
+				Field:   item.Field,
+				From:    item.FromString,
+				To:      item.ToString,
+			})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Created.Before(entries[j].Created)
+	})
+	return entries, nil
+}
+
+// containsFold reports whether the list contains the value, ignoring case
+func containsFold(list []string, value string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// historyValue shortens a changed value to one line, e.g. a description
+func historyValue(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if value == "" {
+		return "(none)"
+	}
+	if runes := []rune(value); len(runes) > 60 {
+		return string(runes[:57]) + "..."
+	}
+	return value
+}
+
+// formatHistory formats field changes as one line each: when, who, field, and from -> to
+func formatHistory(key string, entries []historyEntry) string {
+	if len(entries) == 0 {
+		return fmt.Sprintf("No changes found for %s\n", key)
+	}
+
+	var sb strings.Builder
+	for _, e := range entries {
+		author := e.Author
+		if author == "" {
+			author = "Anonymous"
+		}
+		fmt.Fprintf(&sb, "%s  %-20s %-15s %s -> %s\n", e.Created.Format("2006-01-02 15:04"), author, e.Field, historyValue(e.From), historyValue(e.To))
+	}
+	return sb.String()
+}
+
+// printHistory prints the field changes of the current issue
+func printHistory(ctx context.Context, fields []string) error {
+	entries, err := getHistory(ctx, client, issueKey, fields)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatHistory(issueKey, entries))
+	return nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestGetHistory(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/PROJ-1", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("expand"); got != "changelog" {
+			t.Errorf("Expected expand=changelog, got %q", got)
+		}
+		w.Write([]byte(`{"key": "PROJ-1", "fields": {"summary": "Test"}, "changelog": {"histories": [
+			{"author": {"displayName": "John Doe"}, "created": "2026-10-09T11:03:00.000+0000", "items": [
+				{"field": "status", "fromString": "In Progress", "toString": "To Do"}
+			]},
+			{"author": {"displayName": "Jane Doe"}, "created": "2026-10-05T09:12:00.000+0000", "items": [
+				{"field": "assignee", "fromString": null, "toString": "John Doe"},
+				{"field": "status", "fromString": "To Do", "toString": "In Progress"}
+			]}
+		]}}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	entries, err := getHistory(context.Background(), c, "PROJ-1", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := `2026-10-05 09:12  Jane Doe             assignee        (none) -> John Doe
+2026-10-05 09:12  Jane Doe             status          To Do -> In Progress
+2026-10-09 11:03  John Doe             status          In Progress -> To Do
+`
+	if got := formatHistory("PROJ-1", entries); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	entries, err = getHistory(context.Background(), c, "PROJ-1", []string{"Assignee"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Field != "assignee" {
+		t.Errorf("Expected only the assignee change, got %+v", entries)
+	}
+}
+
+func TestHistoryValue(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"", "(none)"},
+		{"line one\nline two", "line one line two"},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa..."},
+	}
+	for _, tt := range tests {
+		if got := historyValue(tt.value); got != tt.want {
+			t.Errorf("historyValue(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestCompleteChangelogs(t *testing.T) {
+	histories := func(n int) []jira.ChangelogHistory {
+		var hs []jira.ChangelogHistory
+		for i := 0; i < n; i++ {
+			hs = append(hs, jira.ChangelogHistory{Id: fmt.Sprint(i), Created: "2026-10-05T09:12:00.000+0000"})
+		}
+		return hs
+	}
+
+	for _, deployment := range []string{"Cloud", "Server"} {
+		t.Run(deployment, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rest/api/2/issue/PROJ-1/changelog", func(w http.ResponseWriter, r *http.Request) {
+				if deployment != "Cloud" {
+					t.Errorf("Expected the changelog to be complete on Server")
+				}
+				startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+				n := min(100, 150-startAt)
+				json.NewEncoder(w).Encode(map[string]any{"total": 150, "isLast": startAt+n >= 150, "values": histories(n)})
+			})
+			c := newTestClient(t, deployment, mux)
+
+			issues := []jira.Issue{
+				{Key: "PROJ-1", Changelog: &jira.Changelog{Histories: histories(changelogCap)}},
+				{Key: "PROJ-2", Changelog: &jira.Changelog{Histories: histories(3)}},
+			}
+			if err := completeChangelogs(context.Background(), c, issues); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			want := changelogCap
+			if deployment == "Cloud" {
+				want = 150
+			}
+			if got := len(issues[0].Changelog.Histories); got != want {
+				t.Errorf("Expected %d histories, got %d", want, got)
+			}
+			if got := len(issues[1].Changelog.Histories); got != 3 {
+				t.Errorf("Expected a short changelog to be left alone, got %d histories", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/kitproj/jira-cli/internal/config"
+)
+
+// inboxWindow is how far back the inbox looks before it has been marked read
+const inboxWindow = 7 * 24 * time.Hour
+
+// inboxItem is a comment for the current user: one mentioning them, or on an issue they reported or watch
+type inboxItem struct {
+	At        time.Time
+	Key       string
+	Summary   string
+	Author    string
+	Body      string
+	Mentioned bool
+	Reported  bool
+}
+
+// inboxMarkerPath is the file that records when the inbox was last marked read; it is a variable so tests can replace it
+var inboxMarkerPath = func() (string, error) {
+	return config.Path("inbox.json")
+}
+
+// readInboxMarker returns when the inbox was last marked read, or the zero time if it never was
+func readInboxMarker() (time.Time, error) {
+	path, err := inboxMarkerPath()
+	if err != nil {
+		return time.Time{}, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read inbox marker: %w", err)
+	}
+	var marker struct {
+		LastSeen time.Time `json:"lastSeen"`
+	}
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return marker.LastSeen, nil
+}
+
+// writeInboxMarker records that the inbox has been read up to a time
+func writeInboxMarker(lastSeen time.Time) error {
+	path, err := inboxMarkerPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	data, err := json.MarshalIndent(struct {
+		LastSeen time.Time `json:"lastSeen"`
+	}{lastSeen}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal inbox marker: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write inbox marker: %w", err)
+	}
+	return nil
+}
+
+// mentionsUser reports whether a comment body mentions a user, as "[~username]" or "[~accountid:id]"
+func mentionsUser(body string, user *jira.User) bool {
+	return (user.Name != "" && strings.Contains(body, mentionMarkup(*user, false))) ||
+		(user.AccountID != "" && strings.Contains(body, mentionMarkup(*user, true)))
+}
+
+// getInbox finds the comments by others since a time that mention the current user, or are on issues
+// they reported or watch, oldest first
+func getInbox(ctx context.Context, client *jira.Client, since, now time.Time) ([]inboxItem, error) {
+	me, _, err := client.User.GetSelfWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	// There is no JQL for mentions, so issues whose comments contain the mention are searched too, and the
+	// mentions picked out of their comments. A mention is "[~username]" on Server/Data Center and
+	// "[~accountid:id]" on Cloud, where the display name is searched as well, as the text of the mention.
+	clauses := []string{"watcher = currentUser()", "reporter = currentUser()"}
+	if me.Name != "" {
+		clauses = append(clauses, "comment ~ "+quoteJQL(me.Name))
+	}
+	if me.AccountID != "" {
+		clauses = append(clauses, "comment ~ "+quoteJQL(me.AccountID), "comment ~ "+quoteJQL(me.DisplayName))
+	}
+	// JQL dates are in the user's profile timezone, which since need not be in, so the search goes back a
+	// number of minutes from now, rounded up, and the comments are picked by their exact time below
+	minutes := max(int(math.Ceil(now.Sub(since).Minutes())), 1)
+	jql := fmt.Sprintf("updated >= -%dm AND (%s) ORDER BY updated DESC", minutes, strings.Join(clauses, " OR "))
+	issues, err := searchIssues(ctx, client, jql, &jira.SearchOptions{Fields: []string{"summary", "reporter", "watches", "comment"}})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []inboxItem
+	for _, issue := range issues {
+		f := issue.Fields
+		if f.Comments == nil {
+			continue
+		}
+		reported := sameUser(f.Reporter, me)
+		watching := f.Watches != nil && f.Watches.IsWatching
+		for _, comment := range f.Comments.Comments {
+			at, err := time.Parse(jiraTimeLayout, comment.Created)
+			if err != nil || !at.After(since) || sameUser(&comment.Author, me) {
+				continue
+			}
+			mentioned := mentionsUser(comment.Body, me)
+			if !mentioned && !reported && !watching {
+				continue
+			}
+			items = append(items, inboxItem{
+				At:        at,
+				Key:       issue.Key,
+				Summary:   f.Summary,
+				Author:    comment.Author.DisplayName,
+				Body:      comment.Body,
+				Mentioned: mentioned,
+				Reported:  reported,
+			})
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].At.Before(items[j].At) })
+	return items, nil
+}
+
+// formatInbox formats each comment with when, where and who, why it is in the inbox, and its first line
+func formatInbox(items []inboxItem) string {
+	if len(items) == 0 {
+		return "No new comments\n"
+	}
+	var sb strings.Builder
+	for _, item := range items {
+		why := "commented on an issue you watch"
+		if item.Mentioned {
+			why = "mentioned you"
+		} else if item.Reported {
+			why = "commented on your issue"
+		}
+		author := item.Author
+		if author == "" {
+			author = "Anonymous"
+		}
+		fmt.Fprintf(&sb, "%s  %-15s %s %s\n", item.At.Local().Format("2006-01-02 15:04"), item.Key, author, why)
+		fmt.Fprintf(&sb, "    %s: %s\n", item.Summary, historyValue(item.Body))
+	}
+	return sb.String()
+}
+
+// printInbox prints the comments since the inbox was last marked read, or of the last week if it never was,
+// and with markRead moves the marker past them
+func printInbox(ctx context.Context, markRead bool) error {
+	since, err := readInboxMarker()
+	if err != nil {
+		return err
+	}
+	if since.IsZero() {
+		since = time.Now().Add(-inboxWindow)
+	}
+
+	items, err := getInbox(ctx, client, since, time.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Print(formatInbox(items))
+
+	if markRead && len(items) > 0 {
+		return writeInboxMarker(items[len(items)-1].At)
+	}
+	return nil
+}
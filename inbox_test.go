@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetInbox(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "jdoe", "displayName": "John Doe"}`))
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if jql := r.URL.Query().Get("jql"); !strings.Contains(jql, `comment ~ "jdoe"`) {
+			t.Errorf("Unexpected JQL: %s", jql)
+		}
+		w.Write([]byte(`{"total": 3, "issues": [
+			{"key": "PROJ-1", "fields": {"summary": "Add search endpoint", "reporter": {"name": "jdoe"}, "comment": {"comments": [
+				{"author": {"name": "bsmith", "displayName": "Bob Smith"}, "body": "Verified on staging", "created": "2026-10-17T09:40:00.000+0000"},
+				{"author": {"name": "jdoe", "displayName": "John Doe"}, "body": "Thanks", "created": "2026-10-17T10:00:00.000+0000"},
+				{"author": {"name": "bsmith", "displayName": "Bob Smith"}, "body": "Already seen", "created": "2026-10-15T09:00:00.000+0000"}
+			]}}},
+			{"key": "PROJ-2", "fields": {"summary": "Paginate search results", "reporter": {"name": "asmith"}, "comment": {"comments": [
+				{"author": {"name": "asmith", "displayName": "Jane Doe"}, "body": "[~jdoe] can you check\nthe page size?", "created": "2026-10-16T14:02:00.000+0000"}
+			]}}},
+			{"key": "PROJ-3", "fields": {"summary": "Mentions someone else", "reporter": {"name": "asmith"}, "comment": {"comments": [
+				{"author": {"name": "asmith", "displayName": "Jane Doe"}, "body": "[~jdoe2] please review", "created": "2026-10-16T15:00:00.000+0000"}
+			]}}}
+		]}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	items, err := getInbox(context.Background(), c, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items, got %+v", items)
+	}
+	if items[0].Key != "PROJ-2" || !items[0].Mentioned {
+		t.Errorf("Expected the mention on PROJ-2 first, got %+v", items[0])
+	}
+	if items[1].Key != "PROJ-1" || items[1].Mentioned || !items[1].Reported {
+		t.Errorf("Expected the comment on the reported PROJ-1 second, got %+v", items[1])
+	}
+	if got := formatInbox(items); !strings.Contains(got, "Jane Doe mentioned you\n    Paginate search results: [~jdoe] can you check the page size?\n") ||
+		!strings.Contains(got, "Bob Smith commented on your issue\n") {
+		t.Errorf("Unexpected inbox:\n%s", got)
+	}
+}
+
+func TestInboxMarker(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jira-cli", "inbox.json")
+	saved := inboxMarkerPath
+	inboxMarkerPath = func() (string, error) { return path, nil }
+	t.Cleanup(func() { inboxMarkerPath = saved })
+
+	lastSeen, err := readInboxMarker()
+	if err != nil || !lastSeen.IsZero() {
+		t.Fatalf("Expected no marker, got %v, %v", lastSeen, err)
+	}
+
+	want := time.Date(2026, 10, 17, 9, 40, 0, 0, time.UTC)
+	if err := writeInboxMarker(want); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if lastSeen, err := readInboxMarker(); err != nil || !lastSeen.Equal(want) {
+		t.Errorf("Expected %v, got %v, %v", want, lastSeen, err)
+	}
+}
+
+func TestGetInbox_Cloud(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"accountId": "5b10ac8d82e05b22cc7d4ef5", "displayName": "John Doe"}`))
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if jql := r.URL.Query().Get("jql"); !strings.Contains(jql, `comment ~ "5b10ac8d82e05b22cc7d4ef5"`) {
+			t.Errorf("Expected the account ID to be searched, got JQL: %s", jql)
+		}
+		w.Write([]byte(`{"total": 1, "issues": [
+			{"key": "PROJ-2", "fields": {"summary": "Paginate search results", "reporter": {"accountId": "other"}, "comment": {"comments": [
+				{"author": {"accountId": "other", "displayName": "Jane Doe"}, "body": "[~accountid:5b10ac8d82e05b22cc7d4ef5] can you check?", "created": "2026-10-16T14:02:00.000+0000"},
+				{"author": {"accountId": "other", "displayName": "Jane Doe"}, "body": "Not for you", "created": "2026-10-16T14:05:00.000+0000"}
+			]}}}
+		]}`))
+	})
+	c := newTestClient(t, "Cloud", mux)
+
+	items, err := getInbox(context.Background(), c, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 || items[0].Key != "PROJ-2" || !items[0].Mentioned {
+		t.Errorf("Expected only the mention on PROJ-2, got %+v", items)
+	}
+}
+
+func TestGetInbox_TimeZones(t *testing.T) {
+	// The marker was saved in +1000 and the comments come back in -0500: 2026-10-16 10:00 +1000 is
+	// 2026-10-15 19:00 -0500, and now is two hours later
+	since := time.Date(2026, 10, 16, 10, 0, 0, 0, time.FixedZone("AEST", 10*60*60))
+	now := since.Add(2 * time.Hour)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "jdoe", "displayName": "John Doe", "timeZone": "America/New_York"}`))
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if jql := r.URL.Query().Get("jql"); !strings.HasPrefix(jql, "updated >= -120m AND ") {
+			t.Errorf("Expected the search to go back two hours, got JQL: %s", jql)
+		}
+		w.Write([]byte(`{"total": 1, "issues": [
+			{"key": "PROJ-1", "fields": {"summary": "Add search endpoint", "reporter": {"name": "jdoe"}, "comment": {"comments": [
+				{"author": {"name": "bsmith", "displayName": "Bob Smith"}, "body": "Before", "created": "2026-10-15T18:59:00.000-0500"},
+				{"author": {"name": "bsmith", "displayName": "Bob Smith"}, "body": "After", "created": "2026-10-15T19:01:00.000-0500"}
+			]}}}
+		]}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	items, err := getInbox(context.Background(), c, since, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 || items[0].Body != "After" {
+		t.Errorf("Expected only the comment after the marker, got %+v", items)
+	}
+}
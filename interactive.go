@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kitproj/jira-cli/internal/config"
+	"golang.org/x/term"
+)
+
+// isInteractive reports whether we can prompt the user for input
+var isInteractive = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stderr.Fd()))
+}
+
+// prompter asks questions on out and reads the answers from in
+type prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func newPrompter(in io.Reader, out io.Writer) *prompter {
+	return &prompter{in: bufio.NewReader(in), out: out}
+}
+
+// readLine reads one line of input, without the trailing newline
+func (p *prompter) readLine() (string, error) {
+	line, err := p.in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// ask asks for a free-text value. An empty answer returns the default, and is refused if required and there is no default.
+func (p *prompter) ask(label, def string, required bool) (string, error) {
+	for {
+		if def != "" {
+			fmt.Fprintf(p.out, "%s [%s]: ", label, def)
+		} else {
+			fmt.Fprintf(p.out, "%s: ", label)
+		}
+		answer, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+		if answer == "" {
+			answer = def
+		}
+		if answer != "" || !required {
+			return answer, nil
+		}
+		fmt.Fprintf(p.out, "%s is required\n", label)
+	}
+}
+
+// choose asks the user to pick one of the options, by number or by name (ignoring case)
+func (p *prompter) choose(label string, options []string, def string) (string, error) {
+	fmt.Fprintf(p.out, "%s:\n", label)
+	for i, option := range options {
+		fmt.Fprintf(p.out, "  %2d) %s\n", i+1, option)
+	}
+	for {
+		answer, err := p.ask("Choose", def, true)
+		if err != nil {
+			return "", err
+		}
+		if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(options) {
+			return options[n-1], nil
+		}
+		for _, option := range options {
+			if strings.EqualFold(option, answer) {
+				return option, nil
+			}
+		}
+		fmt.Fprintf(p.out, "%q is not one of the options\n", answer)
+	}
+}
+
+// createIssueInteractive prompts for anything the request is missing: project, issue type, summary,
+// description, assignee and then each required field of the project's create screen
+func createIssueInteractive(ctx context.Context, req issueRequest) error {
+	p := newPrompter(os.Stdin, os.Stderr)
+	defaults, _ := config.LoadDefaults()
+
+	if req.Project == "" {
+		projects, _, err := client.Project.GetListWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list projects: %w", err)
+		}
+		var keys []string
+		for _, project := range *projects {
+			keys = append(keys, project.Key)
+		}
+		sort.Strings(keys)
+		if req.Project, err = p.choose("Project", keys, defaults.Project); err != nil {
+			return err
+		}
+	}
+
+	if req.IssueType == "" {
+		issueTypes, err := getCreateMetaIssueTypes(ctx, client, req.Project)
+		if err != nil {
+			return err
+		}
+		var names []string
+		for _, issueType := range issueTypes {
+			names = append(names, issueType.Name)
+		}
+		if req.IssueType, err = p.choose("Issue type", names, defaults.IssueType); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if req.Summary == "" {
+		if req.Summary, err = p.ask("Summary", "", true); err != nil {
+			return err
+		}
+	}
+	if req.Description == "" {
+		if req.Description, err = p.ask("Description (optional)", "", false); err != nil {
+			return err
+		}
+	}
+	if req.Assignee == "" {
+		if req.Assignee, err = p.ask("Assignee (optional, e.g. me)", "", false); err != nil {
+			return err
+		}
+	}
+
+	fields, err := getCreateMeta(ctx, client, req.Project, req.IssueType)
+	if err != nil {
+		return err
+	}
+	if req.Fields == nil {
+		req.Fields = map[string][]string{}
+	}
+	for _, field := range fields {
+		if !field.Required || field.HasDefaultValue || setByRequest[field.ID] {
+			continue
+		}
+		if (field.ID == "description" && req.Description != "") || (field.ID == "assignee" && req.Assignee != "") {
+			continue
+		}
+		if _, ok := req.Fields[field.Name]; ok {
+			continue
+		}
+		if _, ok := req.Fields[field.ID]; ok {
+			continue
+		}
+
+		var value string
+		if len(field.AllowedValues) > 0 && field.Schema.Type != "array" {
+			var labels []string
+			for _, v := range field.AllowedValues {
+				labels = append(labels, v.label())
+			}
+			value, err = p.choose(field.Name, labels, "")
+		} else {
+			label := field.Name
+			if field.Schema.Type == "array" {
+				var labels []string
+				for _, v := range field.AllowedValues {
+					labels = append(labels, v.label())
+				}
+				if len(labels) > 0 {
+					label += fmt.Sprintf(" (comma-separated: %s)", strings.Join(labels, ", "))
+				} else {
+					label += " (comma-separated)"
+				}
+			}
+			value, err = p.ask(label, "", true)
+		}
+		if err != nil {
+			return err
+		}
+		req.Fields[field.ID] = []string{value}
+	}
+
+	return createIssue(ctx, req)
+}
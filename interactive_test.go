@@ -0,0 +1,80 @@
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestPrompter_Choose(t *testing.T) {
+	options := []string{"Bug", "Story", "Task"}
+	tests := []struct {
+		name  string
+		input string
+		def   string
+		want  string
+	}{
+		{"by number", "2\n", "", "Story"},
+		{"by name ignoring case", "task\n", "", "Task"},
+		{"default", "\n", "Bug", "Bug"},
+		{"retries invalid answers", "9\nEpic\n\n1\n", "", "Bug"},
+		{"last line without newline", "3", "", "Task"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPrompter(strings.NewReader(tt.input), io.Discard)
+			got, err := p.choose("Issue type", options, tt.def)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPrompter_Ask(t *testing.T) {
+	p := newPrompter(strings.NewReader("\n  Fix login  \n"), io.Discard)
+	got, err := p.ask("Summary", "", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "Fix login" {
+		t.Errorf("Expected %q, got %q", "Fix login", got)
+	}
+
+	p = newPrompter(strings.NewReader("\n"), io.Discard)
+	got, err = p.ask("Description", "", false)
+	if err != nil || got != "" {
+		t.Errorf("Expected empty optional answer, got %q, %v", got, err)
+	}
+
+	// Running out of input must not loop forever
+	p = newPrompter(strings.NewReader(""), io.Discard)
+	if _, err := p.ask("Summary", "", true); err != io.EOF {
+		t.Errorf("Expected EOF, got %v", err)
+	}
+}
+
+func TestConfirmDeletion(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"PROJ-123\n", true},
+		{"proj-123\n", true},
+		{"y\n", false},
+		{"\n", false},
+	}
+	for _, tt := range tests {
+		var out strings.Builder
+		got, err := confirmDeletion(newPrompter(strings.NewReader(tt.input), &out), "PROJ-123")
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("confirmDeletion(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
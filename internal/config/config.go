@@ -17,17 +17,28 @@ const (
 // config represents the jira-cli configuration
 type config struct {
 	Host string `json:"host"`
+	Defaults
 }
 
-// getConfigPath returns the path to the config file
-func getConfigPath() (string, error) {
+// Defaults are the values offered first when creating issues interactively
+type Defaults struct {
+	Project   string `json:"project,omitempty"`
+	IssueType string `json:"issueType,omitempty"`
+}
+
+// Path returns the path to a file in the jira-cli config directory
+func Path(name string) (string, error) {
 	configDirPath, err := os.UserConfigDir()
 	if err != nil {
 		return "", fmt.Errorf("failed to get config directory: %w", err)
 	}
 
-	configPath := filepath.Join(configDirPath, "jira-cli", configFile)
-	return configPath, nil
+	return filepath.Join(configDirPath, "jira-cli", name), nil
+}
+
+// getConfigPath returns the path to the config file
+func getConfigPath() (string, error) {
+	return Path(configFile)
 }
 
 // SaveConfig saves the host to the config file
@@ -43,7 +54,13 @@ func SaveConfig(host string) error {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
-	cfg := config{Host: host}
+	// Keep any other settings that were added to the file by hand
+	var cfg config
+	if data, err := os.ReadFile(configPath); err == nil {
+		_ = json.Unmarshal(data, &cfg)
+	}
+	cfg.Host = host
+
 	data, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
@@ -58,22 +75,40 @@ func SaveConfig(host string) error {
 
 // LoadConfig loads the host from the config file
 func LoadConfig() (string, error) {
-	configPath, err := getConfigPath()
+	cfg, err := loadConfig()
 	if err != nil {
 		return "", err
 	}
+	return cfg.Host, nil
+}
+
+// LoadDefaults loads the defaults for creating issues from the config file
+func LoadDefaults() (Defaults, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return Defaults{}, err
+	}
+	return cfg.Defaults, nil
+}
+
+// loadConfig reads and parses the config file
+func loadConfig() (*config, error) {
+	configPath, err := getConfigPath()
+	if err != nil {
+		return nil, err
+	}
 
 	data, err := os.ReadFile(configPath)
 	if err != nil {
-		return "", fmt.Errorf("failed to read config file: %w", err)
+		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
 	var cfg config
 	if err := json.Unmarshal(data, &cfg); err != nil {
-		return "", fmt.Errorf("failed to parse config file: %w", err)
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
-	return cfg.Host, nil
+	return &cfg, nil
 }
 
 // SaveToken saves the token to the keyring
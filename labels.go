@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// listField is a multi-value field that is edited by adding and removing values, rather than by setting
+// the whole list, so that edits made at the same time by someone else are kept
+type listField struct {
+	ID     string
+	Plural string
+}
+
+var (
+	labelsField      = listField{ID: "labels", Plural: "labels"}
+	componentsField  = listField{ID: "components", Plural: "components"}
+	fixVersionsField = listField{ID: "fixVersions", Plural: "fix versions"}
+)
+
+// editListField adds values to or removes them from a field of an issue. Components and versions are
+// matched by name against the issue's project, so a typo is reported with the names that exist.
+func editListField(ctx context.Context, client *jira.Client, key string, field listField, action string, values []string) (string, error) {
+	if action != "add" && action != "remove" {
+		return "", fmt.Errorf("unknown action %q: expected add or remove", action)
+	}
+	if len(values) == 0 {
+		return "", fmt.Errorf("no %s given", field.Plural)
+	}
+
+	names := values
+	if field != labelsField {
+		var err error
+		if names, err = projectValueNames(ctx, client, key, field, values); err != nil {
+			return "", err
+		}
+	}
+
+	var operations []map[string]any
+	for _, name := range names {
+		var value any = name
+		if field == labelsField {
+			if strings.ContainsAny(name, " \t") {
+				return "", fmt.Errorf("label %q cannot contain spaces", name)
+			}
+		} else {
+			value = map[string]any{"name": name}
+		}
+		operations = append(operations, map[string]any{action: value})
+	}
+
+	update := map[string]any{"update": map[string]any{field.ID: operations}}
+	if _, err := client.Issue.UpdateIssueWithContext(ctx, key, update); err != nil {
+		return "", fmt.Errorf("failed to update %s: %w", field.Plural, err)
+	}
+
+	if action == "add" {
+		return fmt.Sprintf("Added %s to %s: %s", field.Plural, key, strings.Join(names, ", ")), nil
+	}
+	return fmt.Sprintf("Removed %s from %s: %s", field.Plural, key, strings.Join(names, ", ")), nil
+}
+
+// projectValueNames matches component or version names, ignoring case, against those of the issue's project
+func projectValueNames(ctx context.Context, client *jira.Client, key string, field listField, values []string) ([]string, error) {
+	// The project comes from the issue, as its key may be an ID or the key it had before it was moved
+	issue, _, err := client.Issue.GetWithContext(ctx, key, &jira.GetQueryOptions{Fields: "project"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	projectKey := issue.Fields.Project.Key
+	project, _, err := client.Project.GetWithContext(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var available []string
+	if field == componentsField {
+		for _, component := range project.Components {
+			available = append(available, component.Name)
+		}
+	} else {
+		for _, version := range project.Versions {
+			available = append(available, version.Name)
+		}
+	}
+
+	var names []string
+	for _, value := range values {
+		name := ""
+		for _, a := range available {
+			if strings.EqualFold(a, value) {
+				name = a
+				break
+			}
+		}
+		if name == "" {
+			var quoted []string
+			for _, a := range available {
+				quoted = append(quoted, fmt.Sprintf("%q", a))
+			}
+			return nil, fmt.Errorf("%q is not one of the %s of %s. Available %s: %s", value, field.Plural, projectKey, field.Plural, strings.Join(quoted, ", "))
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// editIssueListField adds values to or removes them from a field of the current issue
+func editIssueListField(ctx context.Context, field listField, action string, values []string) error {
+	message, err := editListField(ctx, client, issueKey, field, action, values)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(message)
+	return nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestEditListField(t *testing.T) {
+	var body map[string]any
+	mux := http.NewServeMux()
+	// OLD-1 was moved to PROJ, so its old key no longer names its project
+	mux.HandleFunc("GET /rest/api/2/issue/OLD-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key": "PROJ-7", "fields": {"project": {"key": "PROJ"}}}`))
+	})
+	mux.HandleFunc("PUT /rest/api/2/issue/OLD-1", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/rest/api/2/project/PROJ", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key": "PROJ", "components": [{"name": "API"}, {"name": "Web UI"}], "versions": [{"name": "2.0.0"}]}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	tests := []struct {
+		name    string
+		field   listField
+		action  string
+		values  []string
+		want    string
+		wantErr string
+	}{
+		{"add labels", labelsField, "add", []string{"backend", "urgent"}, `{"update":{"labels":[{"add":"backend"},{"add":"urgent"}]}}`, ""},
+		{"remove components", componentsField, "remove", []string{"web ui"}, `{"update":{"components":[{"remove":{"name":"Web UI"}}]}}`, ""},
+		{"add fix version", fixVersionsField, "add", []string{"2.0.0"}, `{"update":{"fixVersions":[{"add":{"name":"2.0.0"}}]}}`, ""},
+		{"unknown component", componentsField, "add", []string{"Backend"}, "", `"Backend" is not one of the components of PROJ. Available components: "API", "Web UI"`},
+		{"label with a space", labelsField, "add", []string{"needs review"}, "", `label "needs review" cannot contain spaces`},
+		{"unknown action", labelsField, "set", []string{"backend"}, "", `unknown action "set"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body = nil
+			_, err := editListField(context.Background(), c, "OLD-1", tt.field, tt.action, tt.values)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			got, _ := json.Marshal(body)
+			if string(got) != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
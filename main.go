@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/kitproj/jira-cli/internal/config"
@@ -32,16 +33,51 @@ func main() {
 		fmt.Fprintf(w, "Usage:")
 		fmt.Fprintln(w)
 		fmt.Fprintln(w, "  jira configure <host> - Configure JIRA host and token (reads token from stdin)")
-		fmt.Fprintln(w, "  jira create-issue <project> <issue-type> <title> <description> [assignee] - Create a new JIRA issue")
+		fmt.Fprintln(w, "  jira create-issue [--field name=value]... <project> <issue-type> <title> <description> [assignee] - Create a new JIRA issue")
+		fmt.Fprintln(w, "  jira create-issues -f <plan-file> [--project <project>] [--type <issue-type>] - Create issues from a YAML, CSV or Markdown plan")
 		fmt.Fprintln(w, "  jira get-issue <issue-key> - Get details of the specified JIRA issue")
 		fmt.Fprintln(w, "  jira list-issues - List issues assigned to the current user")
 		fmt.Fprintln(w, "  jira update-issue-status <issue-key> <status> - Update the status of the specified JIRA issue")
 		fmt.Fprintln(w, "  jira get-comments <issue-key> - Get comments of the specified JIRA issue")
 		fmt.Fprintln(w, "  jira add-comment <issue-key> <comment> - Add a comment to the specified JIRA issue")
 		fmt.Fprintln(w, "  jira attach-file <issue-key> <file-path> - Attach a file to the specified JIRA issue")
-		fmt.Fprintln(w, "  jira assign-issue <issue-key> <assignee> - Assign an issue to a user")
+		fmt.Fprintln(w, "  jira assign-issue <issue-key> <assignee> - Assign an issue to a user (username, email, display name, \"me\" or \"none\")")
+		fmt.Fprintln(w, "  jira clone-issue <issue-key> [--project <project>] [--summary-prefix <prefix>] [--with-subtasks] [--with-links] [--with-attachments] - Clone an issue, optionally into another project")
+		fmt.Fprintln(w, "  jira move-issue <issue-key> --project <project> [--type <issue-type>] [--status <status>] [--field name=value]... [--copy [--delete-original [--yes]]] - Move an issue to another project or issue type")
+		fmt.Fprintln(w, "  jira delete-issue <issue-key> [--with-subtasks] [--yes] - Delete an issue, after typing its key to confirm")
+		fmt.Fprintln(w, "  jira watch <issue-key> [user] - Watch an issue, or make another user watch it")
+		fmt.Fprintln(w, "  jira unwatch <issue-key> [user] - Stop watching an issue, or stop another user watching it")
+		fmt.Fprintln(w, "  jira list-watchers <issue-key> - List the users watching an issue")
+		fmt.Fprintln(w, "  jira vote <issue-key> - Vote for an issue")
+		fmt.Fprintln(w, "  jira unvote <issue-key> - Remove your vote for an issue")
+		fmt.Fprintln(w, "  jira label add|remove <issue-key> <label>... - Add or remove labels")
+		fmt.Fprintln(w, "  jira component add|remove <issue-key> <component>... - Add or remove components")
+		fmt.Fprintln(w, "  jira fix-version add|remove <issue-key> <fix-version>... - Add or remove fix versions")
+		fmt.Fprintln(w, "  jira version list|create|release|archive <project> [version] - Manage the versions of a project")
+		fmt.Fprintln(w, "  jira release-notes <project> <version> [--format markdown|html] - Generate release notes for a version")
+		fmt.Fprintln(w, "  jira epics <project> [--all] - List the epics of a project, only those not done unless --all")
+		fmt.Fprintln(w, "  jira epic-issues <epic-key> - Show the progress of an epic and list its issues")
+		fmt.Fprintln(w, "  jira epic add|remove <epic-key> <issue-key>... - Add issues to or remove them from an epic")
+		fmt.Fprintln(w, "  jira tree <issue-key> [--links] [--output text|json] - Show the tree of an epic's issues and their sub-tasks")
+		fmt.Fprintln(w, "  jira graph <issue-key|jql> [--depth N] [--format dot|mermaid] - Export the graph of linked and child issues")
+		fmt.Fprintln(w, "  jira ready <jql|epic-key> - List the unresolved issues ready to start, and the order to work in given their blocks links")
+		fmt.Fprintln(w, "  jira history <issue-key> [--field name]... - List the field changes of an issue, oldest first")
+		fmt.Fprintln(w, "  jira metrics cycle-time <jql> [--from-status <status>] [--to-status <status>] [--output text|csv|json] - Cycle time, lead time and time in each status")
+		fmt.Fprintln(w, "  jira forecast <jql> [--history 90d] [--runs 10000] [--throughput-jql <jql>] - Forecast when the unresolved issues will be done")
+		fmt.Fprintln(w, "  jira standup [--since yesterday] - List the issues you created, moved, commented on or logged work on, grouped as done, in progress and blocked")
+		fmt.Fprintln(w, "  jira inbox [--mark-read] - List new comments mentioning you or on issues you reported or watch")
+		fmt.Fprintln(w, "  jira watch-jql <jql> [--interval 60s] [--output text|json] [--exec <command>] - Poll a search and report issues added, removed, moved, assigned or commented on")
+		fmt.Fprintln(w, "  jira find-user <query> - Find users by username, email or display name")
 		fmt.Fprintln(w, "  jira add-issue-to-sprint <issue-key> - Add an issue to the current sprint")
-		fmt.Fprintln(w, "  jira mcp-server - Start MCP server (stdio transport)")
+		fmt.Fprintln(w, "  jira sprint report (--board <board> | --sprint <sprint>) - Report committed, added, completed and carried over work of a sprint")
+		fmt.Fprintln(w, "  jira velocity --board <board> [--last N] - Committed and completed work of the last closed sprints, and their average")
+		fmt.Fprintln(w, "  jira burndown (--board <board> | --sprint <sprint>) [--issues] [--output chart|csv] - Chart the remaining work of a sprint by day")
+		fmt.Fprintln(w, "  jira list-projects - List projects")
+		fmt.Fprintln(w, "  jira list-issue-types <project> - List issue types of a project")
+		fmt.Fprintln(w, "  jira list-statuses <project> - List statuses of each issue type in a project")
+		fmt.Fprintln(w, "  jira list-priorities - List priorities")
+		fmt.Fprintln(w, "  jira list-fields - List fields, including custom field IDs")
+		fmt.Fprintln(w, "  jira mcp-server [--allow-delete] - Start MCP server (stdio transport)")
 		fmt.Fprintln(w)
 		fmt.Fprintln(w, "Options:")
 		flag.PrintDefaults()
@@ -70,19 +106,57 @@ func run(ctx context.Context, args []string) error {
 		}
 		return configure(args[1])
 	case "create-issue":
-		if len(args) < 5 {
-			return fmt.Errorf("usage: jira create-issue <project> <issue-type> <title> <description> [assignee]")
+		fields := fieldsFlag{}
+		fs := newFlagSet(command)
+		fs.Var(fields, "field", "additional field as name=value (repeatable)")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil {
+			return fmt.Errorf("usage: jira create-issue [--field name=value]... <project> <issue-type> <title> <description> [assignee]: %w", err)
 		}
-		project := args[1]
-		issueType := args[2]
-		title := args[3]
-		description := args[4]
-		var assignee string
-		if len(args) >= 6 {
-			assignee = args[5]
+		// Fill in whatever was given, in order: project, issue type, title, description, assignee
+		req := issueRequest{Fields: fields}
+		for i, value := range positional {
+			switch i {
+			case 0:
+				req.Project = value
+			case 1:
+				req.IssueType = value
+			case 2:
+				req.Summary = value
+			case 3:
+				req.Description = value
+			case 4:
+				req.Assignee = value
+			}
+		}
+		if len(positional) < 4 {
+			// On a terminal, prompt for the rest; scripts keep the strict positional arguments
+			if !isInteractive() {
+				return fmt.Errorf("usage: jira create-issue [--field name=value]... <project> <issue-type> <title> <description> [assignee]")
+			}
+			return executeCommand(ctx, func(ctx context.Context) error {
+				return createIssueInteractive(ctx, req)
+			})
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return createIssue(ctx, req)
+		})
+	case "create-issues":
+		var path string
+		var defaults planEntry
+		fs := newFlagSet(command)
+		fs.StringVar(&path, "f", "", "plan file (.yaml, .csv or .md)")
+		fs.StringVar(&defaults.Project, "project", "", "project for entries that do not set one")
+		fs.StringVar(&defaults.IssueType, "type", "", "issue type for entries that do not set one")
+		positional, err := parseFlags(fs, args[1:])
+		if err == nil && path == "" && len(positional) == 1 {
+			path = positional[0]
+		}
+		if err != nil || path == "" {
+			return fmt.Errorf("usage: jira create-issues -f <plan-file> [--project <project>] [--type <issue-type>]")
 		}
 		return executeCommand(ctx, func(ctx context.Context) error {
-			return createIssue(ctx, project, issueType, title, description, assignee)
+			return createIssues(ctx, path, defaults)
 		})
 	case "get-issue":
 		if len(args) < 2 {
@@ -134,6 +208,375 @@ func run(ctx context.Context, args []string) error {
 		return executeCommand(ctx, func(ctx context.Context) error {
 			return assignIssue(ctx, assignee)
 		})
+	case "clone-issue":
+		opts := cloneOptions{}
+		fs := newFlagSet(command)
+		fs.StringVar(&opts.Project, "project", "", "project to clone into")
+		fs.StringVar(&opts.SummaryPrefix, "summary-prefix", "CLONE - ", "prefix for the clone's summary")
+		fs.BoolVar(&opts.WithSubtasks, "with-subtasks", false, "clone sub-tasks too")
+		fs.BoolVar(&opts.WithLinks, "with-links", false, "copy issue links")
+		fs.BoolVar(&opts.WithAttachments, "with-attachments", false, "copy attachments")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 {
+			return fmt.Errorf("usage: jira clone-issue <issue-key> [--project <project>] [--summary-prefix <prefix>] [--with-subtasks] [--with-links] [--with-attachments]")
+		}
+		issueKey = positional[0]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return cloneIssueCommand(ctx, opts)
+		})
+	case "move-issue":
+		opts := moveOptions{Fields: fieldsFlag{}}
+		var yes bool
+		fs := newFlagSet(command)
+		fs.StringVar(&opts.Project, "project", "", "project to move the issue to")
+		fs.StringVar(&opts.IssueType, "type", "", "issue type to change the issue to")
+		fs.StringVar(&opts.Status, "status", "", "status in the target workflow")
+		fs.Var(fieldsFlag(opts.Fields), "field", "required field of the target as name=value (repeatable)")
+		fs.BoolVar(&opts.Copy, "copy", false, "on Data Center, copy the issue to another project, as it cannot be moved")
+		fs.BoolVar(&opts.DeleteOriginal, "delete-original", false, "delete the original after copying it")
+		fs.BoolVar(&yes, "yes", false, "delete the original without asking for confirmation")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 || (opts.Project == "" && opts.IssueType == "") || (opts.DeleteOriginal && !opts.Copy) {
+			return fmt.Errorf("usage: jira move-issue <issue-key> --project <project> [--type <issue-type>] [--status <status>] [--field name=value]... [--copy [--delete-original [--yes]]]")
+		}
+		issueKey = positional[0]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return moveIssueCommand(ctx, opts, yes)
+		})
+	case "delete-issue":
+		var withSubtasks, yes bool
+		fs := newFlagSet(command)
+		fs.BoolVar(&withSubtasks, "with-subtasks", false, "delete the issue's sub-tasks too")
+		fs.BoolVar(&yes, "yes", false, "delete without asking for confirmation")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 {
+			return fmt.Errorf("usage: jira delete-issue <issue-key> [--with-subtasks] [--yes]")
+		}
+		issueKey = positional[0]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return deleteIssueCommand(ctx, withSubtasks, yes)
+		})
+	case "watch", "unwatch":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: jira %s <issue-key> [user]", command)
+		}
+		issueKey = args[1]
+		who := "me"
+		if len(args) > 2 {
+			who = args[2]
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			if command == "watch" {
+				return watchIssue(ctx, who)
+			}
+			return unwatchIssue(ctx, who)
+		})
+	case "list-watchers":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: jira list-watchers <issue-key>")
+		}
+		issueKey = args[1]
+		return executeCommand(ctx, listWatchers)
+	case "vote", "unvote":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: jira %s <issue-key>", command)
+		}
+		issueKey = args[1]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return voteIssue(ctx, command == "vote")
+		})
+	case "label", "component", "fix-version":
+		field := map[string]listField{"label": labelsField, "component": componentsField, "fix-version": fixVersionsField}[command]
+		if len(args) < 4 || (args[1] != "add" && args[1] != "remove") {
+			return fmt.Errorf("usage: jira %s add|remove <issue-key> <%s>...", command, command)
+		}
+		action := args[1]
+		issueKey = args[2]
+		values := args[3:]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return editIssueListField(ctx, field, action, values)
+		})
+	case "version":
+		usage := fmt.Errorf("usage: jira version list <project> | create <project> <name> [--description <text>] [--start-date <date>] [--release-date <date>] | release <project> <name> [--date <date>] | archive <project> <name>")
+		if len(args) < 2 {
+			return usage
+		}
+		action := args[1]
+		var version jira.Version
+		var date string
+		fs := newFlagSet(command)
+		switch action {
+		case "create":
+			fs.StringVar(&version.Description, "description", "", "version description")
+			fs.StringVar(&version.StartDate, "start-date", "", "start date (YYYY-MM-DD)")
+			fs.StringVar(&version.ReleaseDate, "release-date", "", "planned release date (YYYY-MM-DD)")
+		case "release":
+			fs.StringVar(&date, "date", "", "release date (YYYY-MM-DD), today by default")
+		}
+		positional, err := parseFlags(fs, args[2:])
+		if err != nil || len(positional) < 1 || (action != "list" && len(positional) < 2) {
+			return usage
+		}
+		projectKey := positional[0]
+		switch action {
+		case "list":
+			return executeCommand(ctx, func(ctx context.Context) error {
+				return listVersions(ctx, projectKey)
+			})
+		case "create":
+			version.Name = positional[1]
+			return executeCommand(ctx, func(ctx context.Context) error {
+				return createVersion(ctx, projectKey, version)
+			})
+		case "release":
+			return executeCommand(ctx, func(ctx context.Context) error {
+				return releaseVersion(ctx, projectKey, positional[1], date)
+			})
+		case "archive":
+			return executeCommand(ctx, func(ctx context.Context) error {
+				return archiveVersion(ctx, projectKey, positional[1])
+			})
+		}
+		return usage
+	case "release-notes":
+		format := "markdown"
+		fs := newFlagSet(command)
+		fs.StringVar(&format, "format", format, "markdown or html")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 2 || (format != "markdown" && format != "md" && format != "html") {
+			return fmt.Errorf("usage: jira release-notes <project> <version> [--format markdown|html]")
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return releaseNotes(ctx, positional[0], positional[1], format)
+		})
+	case "epics":
+		var all bool
+		fs := newFlagSet(command)
+		fs.BoolVar(&all, "all", false, "include epics that are done")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 {
+			return fmt.Errorf("usage: jira epics <project> [--all]")
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return listEpics(ctx, positional[0], all)
+		})
+	case "epic-issues":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: jira epic-issues <epic-key>")
+		}
+		epicKey := args[1]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return listEpicIssues(ctx, epicKey)
+		})
+	case "epic":
+		if len(args) < 4 || (args[1] != "add" && args[1] != "remove") {
+			return fmt.Errorf("usage: jira epic add|remove <epic-key> <issue-key>...")
+		}
+		action := args[1]
+		epicKey := args[2]
+		keys := args[3:]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return editEpic(ctx, action, epicKey, keys)
+		})
+	case "tree":
+		var links bool
+		output := "text"
+		fs := newFlagSet(command)
+		fs.BoolVar(&links, "links", false, "include linked issues")
+		fs.StringVar(&output, "output", output, "text or json")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 || (output != "text" && output != "json") {
+			return fmt.Errorf("usage: jira tree <issue-key> [--links] [--output text|json]")
+		}
+		issueKey = positional[0]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printTree(ctx, links, output)
+		})
+	case "graph":
+		depth := 2
+		format := "dot"
+		fs := newFlagSet(command)
+		fs.IntVar(&depth, "depth", depth, "how many links or parent/child relations to follow")
+		fs.StringVar(&format, "format", format, "dot or mermaid")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 || depth < 0 || (format != "dot" && format != "mermaid") {
+			return fmt.Errorf("usage: jira graph <issue-key|jql> [--depth N] [--format dot|mermaid]")
+		}
+		start := strings.Join(positional, " ")
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printGraph(ctx, start, depth, format)
+		})
+	case "ready":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: jira ready <jql|epic-key>")
+		}
+		query := strings.Join(args[1:], " ")
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printReadyPlan(ctx, query)
+		})
+	case "history":
+		var fields listFlag
+		fs := newFlagSet(command)
+		fs.Var(&fields, "field", "only changes of this field (repeatable, or comma-separated)")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 {
+			return fmt.Errorf("usage: jira history <issue-key> [--field name]...")
+		}
+		issueKey = positional[0]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printHistory(ctx, fields)
+		})
+	case "metrics":
+		usage := fmt.Errorf("usage: jira metrics cycle-time <jql> [--from-status <status>] [--to-status <status>] [--output text|csv|json]")
+		if len(args) < 2 || args[1] != "cycle-time" {
+			return usage
+		}
+		fromStatus, toStatus, output := "In Progress", "Done", "text"
+		fs := newFlagSet(command)
+		fs.StringVar(&fromStatus, "from-status", fromStatus, "status where cycle time starts")
+		fs.StringVar(&toStatus, "to-status", toStatus, "status where cycle time ends")
+		fs.StringVar(&output, "output", output, "text, csv or json")
+		positional, err := parseFlags(fs, args[2:])
+		if err != nil || len(positional) < 1 || (output != "text" && output != "csv" && output != "json") {
+			return usage
+		}
+		jql := strings.Join(positional, " ")
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printCycleTime(ctx, jql, fromStatus, toStatus, output)
+		})
+	case "forecast":
+		usage := "usage: jira forecast <jql> [--history 90d] [--runs 10000] [--throughput-jql <jql>]"
+		history, runs := "90d", 10000
+		var throughputJQL string
+		fs := newFlagSet(command)
+		fs.StringVar(&history, "history", history, "throughput history window, in days (90d) or weeks (12w)")
+		fs.IntVar(&runs, "runs", runs, "number of simulated runs")
+		fs.StringVar(&throughputJQL, "throughput-jql", "", "JQL for the issues whose resolution gives the throughput, the same projects by default")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 || runs < 1 {
+			return fmt.Errorf("%s", usage)
+		}
+		historyDays, err := parseDays(history)
+		if err != nil {
+			return fmt.Errorf("%s: %w", usage, err)
+		}
+		jql := strings.Join(positional, " ")
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printForecast(ctx, jql, historyDays, runs, throughputJQL)
+		})
+	case "standup":
+		usage := "usage: jira standup [--since yesterday|today|24h|3d|2006-01-02]"
+		sinceFlag := "yesterday"
+		fs := newFlagSet(command)
+		fs.StringVar(&sinceFlag, "since", sinceFlag, "yesterday (the previous working day), today, a duration or a date")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) > 0 {
+			return fmt.Errorf("%s", usage)
+		}
+		since, err := parseSince(sinceFlag, time.Now())
+		if err != nil {
+			return fmt.Errorf("%s: %w", usage, err)
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printStandup(ctx, since)
+		})
+	case "inbox":
+		var markRead bool
+		fs := newFlagSet(command)
+		fs.BoolVar(&markRead, "mark-read", false, "only show the comments after these next time")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) > 0 {
+			return fmt.Errorf("usage: jira inbox [--mark-read]")
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printInbox(ctx, markRead)
+		})
+	case "watch-jql":
+		interval, output := time.Minute, "text"
+		var hook string
+		fs := newFlagSet(command)
+		fs.DurationVar(&interval, "interval", interval, "how often to poll")
+		fs.StringVar(&output, "output", output, "text, or json for one event per line")
+		fs.StringVar(&hook, "exec", "", "shell command to run for each event")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) < 1 || interval < time.Second || (output != "text" && output != "json") {
+			return fmt.Errorf("usage: jira watch-jql <jql> [--interval 60s] [--output text|json] [--exec <command>]")
+		}
+		jql := strings.Join(positional, " ")
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return watchJQL(ctx, client, jql, interval, output, hook, os.Stdout)
+		})
+	case "find-user":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: jira find-user <query>")
+		}
+		query := args[1]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return findUser(ctx, query)
+		})
+	case "list-projects":
+		return executeCommand(ctx, listProjects)
+	case "list-issue-types":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: jira list-issue-types <project>")
+		}
+		project := args[1]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return listIssueTypes(ctx, project)
+		})
+	case "list-statuses":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: jira list-statuses <project>")
+		}
+		project := args[1]
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return listStatuses(ctx, project)
+		})
+	case "list-priorities":
+		return executeCommand(ctx, listPriorities)
+	case "list-fields":
+		return executeCommand(ctx, listFields)
+	case "sprint":
+		var board, sprint string
+		fs := newFlagSet(command)
+		fs.StringVar(&board, "board", "", "board name or ID")
+		fs.StringVar(&sprint, "sprint", "", "sprint name or ID, the board's active sprint by default")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) != 1 || positional[0] != "report" || (board == "" && sprint == "") {
+			return fmt.Errorf("usage: jira sprint report (--board <board> | --sprint <sprint>)")
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printSprintReport(ctx, board, sprint)
+		})
+	case "velocity":
+		var board string
+		last := 6
+		fs := newFlagSet(command)
+		fs.StringVar(&board, "board", "", "board name or ID")
+		fs.IntVar(&last, "last", last, "number of closed sprints")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) > 0 || board == "" || last < 1 {
+			return fmt.Errorf("usage: jira velocity --board <board> [--last N]")
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printVelocity(ctx, board, last)
+		})
+	case "burndown":
+		var board, sprint string
+		var countIssues bool
+		output := "chart"
+		fs := newFlagSet(command)
+		fs.StringVar(&board, "board", "", "board name or ID")
+		fs.StringVar(&sprint, "sprint", "", "sprint name or ID, the board's active sprint by default")
+		fs.BoolVar(&countIssues, "issues", false, "count issues rather than story points")
+		fs.StringVar(&output, "output", output, "chart or csv")
+		positional, err := parseFlags(fs, args[1:])
+		if err != nil || len(positional) > 0 || (board == "" && sprint == "") || (output != "chart" && output != "csv") {
+			return fmt.Errorf("usage: jira burndown (--board <board> | --sprint <sprint>) [--issues] [--output chart|csv]")
+		}
+		return executeCommand(ctx, func(ctx context.Context) error {
+			return printBurndown(ctx, board, sprint, countIssues, output)
+		})
 	case "add-issue-to-sprint":
 		if len(args) < 2 {
 			return fmt.Errorf("usage: jira add-issue-to-sprint <issue-key>")
@@ -141,7 +584,13 @@ func run(ctx context.Context, args []string) error {
 		issueKey = args[1]
 		return executeCommand(ctx, addIssueToSprint)
 	case "mcp-server":
-		return runMCPServer(ctx)
+		var allowDelete bool
+		fs := newFlagSet(command)
+		fs.BoolVar(&allowDelete, "allow-delete", false, "allow tools to delete issues: delete_issue, and move_issue's delete_original")
+		if _, err := parseFlags(fs, args[1:]); err != nil {
+			return fmt.Errorf("usage: jira mcp-server [--allow-delete]: %w", err)
+		}
+		return runMCPServer(ctx, allowDelete)
 	default:
 		return fmt.Errorf("unknown sub-command: %s", command)
 	}
@@ -294,11 +743,16 @@ func updateIssueStatus(ctx context.Context, statusName string) error {
 }
 
 func addComment(ctx context.Context, message string) error {
+	body, err := resolveMentions(ctx, client, message)
+	if err != nil {
+		return err
+	}
+
 	comment := &jira.Comment{
-		Body: message,
+		Body: body,
 	}
 
-	_, _, err := client.Issue.AddCommentWithContext(ctx, issueKey, comment)
+	_, _, err = client.Issue.AddCommentWithContext(ctx, issueKey, comment)
 	if err != nil {
 		return fmt.Errorf("failed to add comment: %w", err)
 	}
@@ -330,31 +784,15 @@ func getComments(ctx context.Context) error {
 	return nil
 }
 
-// createIssue creates a new JIRA issue with the specified project, issue type, title, description, and optional assignee
-func createIssue(ctx context.Context, projectKey, issueType, title, description, assignee string) error {
-	// Create a new issue with the specified issue type
-	issue := &jira.Issue{
-		Fields: &jira.IssueFields{
-			Project: jira.Project{
-				Key: projectKey,
-			},
-			Summary:     title,
-			Description: description,
-			Type: jira.IssueType{
-				Name: issueType,
-			},
-		},
-	}
-
-	// Add assignee if provided
-	if assignee != "" {
-		issue.Fields.Assignee = &jira.User{
-			Name: assignee,
-		}
+// createIssue creates a new JIRA issue, validating it against the project's create screen first
+func createIssue(ctx context.Context, req issueRequest) error {
+	issue, err := buildIssue(ctx, client, req)
+	if err != nil {
+		return err
 	}
 
 	// Create the issue
-	createdIssue, _, err := client.Issue.Create(issue)
+	createdIssue, _, err := client.Issue.CreateWithContext(ctx, issue)
 	if err != nil {
 		return fmt.Errorf("failed to create issue: %w", err)
 	}
@@ -459,20 +897,23 @@ func attachFile(ctx context.Context, filePath string) error {
 	return nil
 }
 
-// assignIssue assigns an issue to a user
+// assignIssue assigns an issue to a user, identified by username, email, display name, "me", or "none" to unassign
 func assignIssue(ctx context.Context, assignee string) error {
-	// Create a User object with the assignee name
-	user := &jira.User{
-		Name: assignee,
+	user, err := resolveAssignee(ctx, client, assignee)
+	if err != nil {
+		return err
 	}
 
 	// Update the assignee
-	_, err := client.Issue.UpdateAssigneeWithContext(ctx, issueKey, user)
-	if err != nil {
+	if err := setAssignee(ctx, client, issueKey, user); err != nil {
 		return fmt.Errorf("failed to assign issue: %w", err)
 	}
 
-	fmt.Printf("Successfully assigned issue %s to %s\n", issueKey, assignee)
+	if user == nil {
+		fmt.Printf("Successfully unassigned issue %s\n", issueKey)
+	} else {
+		fmt.Printf("Successfully assigned issue %s to %s\n", issueKey, userLabel(*user))
+	}
 	return nil
 }
 
@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/kitproj/jira-cli/internal/config"
@@ -13,8 +15,10 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
-// runMCPServer starts the MCP server that communicates over stdio using the mcp-go library
-func runMCPServer(ctx context.Context) error {
+// runMCPServer starts the MCP server that communicates over stdio using the mcp-go library.
+// Deleting issues cannot be undone, so the delete_issue tool, and move_issue's delete_original argument,
+// are only available when allowDelete is set.
+func runMCPServer(ctx context.Context, allowDelete bool) error {
 	// Load host from config file
 	host, err := config.LoadConfig()
 	if err != nil {
@@ -84,7 +88,7 @@ func runMCPServer(ctx context.Context) error {
 		),
 		mcp.WithString("comment",
 			mcp.Required(),
-			mcp.Description("Comment text to add"),
+			mcp.Description("Comment text to add. Use @username, @email or @display.name to mention users"),
 		),
 	)
 	s.AddTool(addCommentTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -120,10 +124,17 @@ func runMCPServer(ctx context.Context) error {
 		),
 		mcp.WithString("description",
 			mcp.Required(),
-			mcp.Description("Issue description"),
+			mcp.Description("Issue description. Use @username, @email or @display.name to mention users"),
 		),
 		mcp.WithString("assignee",
-			mcp.Description("Optional assignee username"),
+			mcp.Description("Optional assignee: username, email, display name, 'me', or 'none' to leave unassigned"),
+		),
+		mcp.WithObject("fields",
+			mcp.Description("Optional additional fields keyed by display name or field ID, e.g. {\"Priority\": \"High\", \"Labels\": [\"backend\"], \"Story Points\": 3}. Values are converted to the field's type; required fields of the project's create screen must be included"),
+		),
+		mcp.WithArray("watchers",
+			mcp.Description("Optional users to add as watchers: username, email, display name or 'me'"),
+			mcp.WithStringItems(),
 		),
 	)
 	s.AddTool(createIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -163,13 +174,211 @@ func runMCPServer(ctx context.Context) error {
 		),
 		mcp.WithString("assignee",
 			mcp.Required(),
-			mcp.Description("Username of the assignee"),
+			mcp.Description("Assignee: username, email, display name, 'me', or 'none' to unassign"),
 		),
 	)
 	s.AddTool(assignIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		return assignIssueHandler(ctx, api, request)
 	})
 
+	// Add move-issue tool
+	moveIssueTool := newMoveIssueTool(allowDelete)
+	s.AddTool(moveIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return moveIssueHandler(ctx, api, host, allowDelete, request)
+	})
+
+	// Add watcher and vote tools
+	watchIssueTool := mcp.NewTool("watch_issue",
+		mcp.WithDescription("Add a watcher to a JIRA issue, so they are notified of changes"),
+		mcp.WithString("issue_key",
+			mcp.Required(),
+			mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+		),
+		mcp.WithString("user",
+			mcp.Description("User to add: username, email, display name or 'me' (default 'me')"),
+		),
+	)
+	s.AddTool(watchIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return watchIssueHandler(ctx, api, request, true)
+	})
+
+	unwatchIssueTool := mcp.NewTool("unwatch_issue",
+		mcp.WithDescription("Remove a watcher from a JIRA issue"),
+		mcp.WithString("issue_key",
+			mcp.Required(),
+			mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+		),
+		mcp.WithString("user",
+			mcp.Description("User to remove: username, email, display name or 'me' (default 'me')"),
+		),
+	)
+	s.AddTool(unwatchIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return watchIssueHandler(ctx, api, request, false)
+	})
+
+	listWatchersTool := mcp.NewTool("list_watchers",
+		mcp.WithDescription("List the users watching a JIRA issue"),
+		mcp.WithString("issue_key",
+			mcp.Required(),
+			mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+		),
+	)
+	s.AddTool(listWatchersTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return listWatchersHandler(ctx, api, request)
+	})
+
+	voteIssueTool := mcp.NewTool("vote_issue",
+		mcp.WithDescription("Vote for a JIRA issue as the current user"),
+		mcp.WithString("issue_key",
+			mcp.Required(),
+			mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+		),
+	)
+	s.AddTool(voteIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return voteIssueHandler(ctx, api, request, true)
+	})
+
+	unvoteIssueTool := mcp.NewTool("unvote_issue",
+		mcp.WithDescription("Remove the current user's vote for a JIRA issue"),
+		mcp.WithString("issue_key",
+			mcp.Required(),
+			mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+		),
+	)
+	s.AddTool(unvoteIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return voteIssueHandler(ctx, api, request, false)
+	})
+
+	// Add label, component and fix version tools
+	for _, tool := range []struct {
+		name  string
+		field listField
+		arg   string
+	}{
+		{"edit_labels", labelsField, "labels"},
+		{"edit_components", componentsField, "components"},
+		{"edit_fix_versions", fixVersionsField, "fix_versions"},
+	} {
+		editTool := mcp.NewTool(tool.name,
+			mcp.WithDescription(fmt.Sprintf("Add or remove %s of a JIRA issue, keeping the others", tool.field.Plural)),
+			mcp.WithString("issue_key",
+				mcp.Required(),
+				mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+			),
+			mcp.WithString("action",
+				mcp.Required(),
+				mcp.Enum("add", "remove"),
+				mcp.Description("Whether to add or remove the values"),
+			),
+			mcp.WithArray(tool.arg,
+				mcp.Required(),
+				mcp.Description(fmt.Sprintf("The %s to add or remove, by name", tool.field.Plural)),
+				mcp.WithStringItems(),
+			),
+		)
+		s.AddTool(editTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return editListFieldHandler(ctx, api, request, tool.field, tool.arg)
+		})
+	}
+
+	// Add epic tools
+	listEpicsTool := mcp.NewTool("list_epics",
+		mcp.WithDescription("List the epics of a JIRA project (key, status and summary)"),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description("Project key (e.g., 'PROJ')"),
+		),
+		mcp.WithBoolean("all",
+			mcp.Description("Include epics that are done (default false)"),
+		),
+	)
+	s.AddTool(listEpicsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return listEpicsHandler(ctx, api, request)
+	})
+
+	getEpicIssuesTool := mcp.NewTool("get_epic_issues",
+		mcp.WithDescription("Get the progress of an epic (issues and story points done, in progress and to do) and the issues in it"),
+		mcp.WithString("epic_key",
+			mcp.Required(),
+			mcp.Description("Epic issue key (e.g., 'PROJ-100')"),
+		),
+	)
+	s.AddTool(getEpicIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return getEpicIssuesHandler(ctx, api, request)
+	})
+
+	editEpicTool := mcp.NewTool("edit_epic",
+		mcp.WithDescription("Add issues to or remove them from an epic"),
+		mcp.WithString("epic_key",
+			mcp.Required(),
+			mcp.Description("Epic issue key (e.g., 'PROJ-100')"),
+		),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Enum("add", "remove"),
+			mcp.Description("Whether to add the issues to the epic or remove them from it"),
+		),
+		mcp.WithArray("issue_keys",
+			mcp.Required(),
+			mcp.Description("Keys of the issues to add or remove"),
+			mcp.WithStringItems(),
+		),
+	)
+	s.AddTool(editEpicTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return editEpicHandler(ctx, api, request)
+	})
+
+	// Add ready-issues tool
+	readyIssuesTool := mcp.NewTool("list_ready_issues",
+		mcp.WithDescription("List the unresolved issues that are ready to start because nothing blocking them is unresolved, the blocked issues and what blocks them, the order to work in given the \"blocks\" links, and any issues that block each other in a cycle"),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("JQL (e.g., 'project = PROJ AND sprint in openSprints()') or the key of an epic"),
+		),
+	)
+	s.AddTool(readyIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return listReadyIssuesHandler(ctx, api, request)
+	})
+
+	// Add issue-history tool
+	getIssueHistoryTool := mcp.NewTool("get_issue_history",
+		mcp.WithDescription("Get the field changes of a JIRA issue, oldest first: when, who, which field, and from -> to (e.g., to find who moved it back to To Do)"),
+		mcp.WithString("issue_key",
+			mcp.Required(),
+			mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+		),
+		mcp.WithArray("fields",
+			mcp.Description("Only changes of these fields (e.g., ['status', 'assignee'])"),
+			mcp.WithStringItems(),
+		),
+	)
+	s.AddTool(getIssueHistoryTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return getIssueHistoryHandler(ctx, api, request)
+	})
+
+	// Add standup tool
+	getStandupTool := mcp.NewTool("get_standup",
+		mcp.WithDescription("List the issues the current user created, changed the status of, commented on or logged work on since a time, grouped as done, in progress, blocked and to do, with what they did on each, to draft a standup update"),
+		mcp.WithString("since",
+			mcp.Description("'yesterday' (the previous working day, the default), 'today', a duration (e.g., '24h', '3d') or a date (e.g., '2026-10-15')"),
+		),
+	)
+	s.AddTool(getStandupTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return getStandupHandler(ctx, api, request)
+	})
+
+	// Add find-user tool
+	findUserTool := mcp.NewTool("find_user",
+		mcp.WithDescription("Find JIRA users by username, email or display name"),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Username, email or display name (or a prefix of one)"),
+		),
+	)
+	s.AddTool(findUserTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return findUserHandler(ctx, api, request)
+	})
+
 	// Add add-issue-to-sprint tool
 	addIssueToSprintTool := mcp.NewTool("add_issue_to_sprint",
 		mcp.WithDescription("Add a JIRA issue to the current active sprint"),
@@ -182,6 +391,70 @@ func runMCPServer(ctx context.Context) error {
 		return addIssueToSprintHandler(ctx, api, request)
 	})
 
+	// Add list-projects tool
+	listProjectsTool := mcp.NewTool("list_projects",
+		mcp.WithDescription("List the JIRA projects visible to the current user (key and name)"),
+	)
+	s.AddTool(listProjectsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return listProjectsHandler(ctx, api, request)
+	})
+
+	// Add list-issue-types tool
+	listIssueTypesTool := mcp.NewTool("list_issue_types",
+		mcp.WithDescription("List the issue types that can be created in a JIRA project"),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description("JIRA project key"),
+		),
+	)
+	s.AddTool(listIssueTypesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return listIssueTypesHandler(ctx, api, request)
+	})
+
+	// Add list-statuses tool
+	listStatusesTool := mcp.NewTool("list_statuses",
+		mcp.WithDescription("List the statuses available to each issue type in a JIRA project"),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description("JIRA project key"),
+		),
+	)
+	s.AddTool(listStatusesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return listStatusesHandler(ctx, api, request)
+	})
+
+	// Add list-priorities tool
+	listPrioritiesTool := mcp.NewTool("list_priorities",
+		mcp.WithDescription("List the priorities a JIRA issue can have"),
+	)
+	s.AddTool(listPrioritiesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return listPrioritiesHandler(ctx, api, request)
+	})
+
+	// Add list-fields tool
+	listFieldsTool := mcp.NewTool("list_fields",
+		mcp.WithDescription("List all JIRA system and custom fields with their IDs (e.g., customfield_10001), names and types"),
+	)
+	s.AddTool(listFieldsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return listFieldsHandler(ctx, api, request)
+	})
+
+	if allowDelete {
+		deleteIssueTool := mcp.NewTool("delete_issue",
+			mcp.WithDescription("Permanently delete a JIRA issue. This cannot be undone"),
+			mcp.WithString("issue_key",
+				mcp.Required(),
+				mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+			),
+			mcp.WithBoolean("with_subtasks",
+				mcp.Description("Also delete the issue's sub-tasks; required if it has any (default false)"),
+			),
+		)
+		s.AddTool(deleteIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return deleteIssueHandler(ctx, api, request)
+		})
+	}
+
 	// Start the stdio server
 	return server.ServeStdio(s)
 }
@@ -285,6 +558,11 @@ func addCommentHandler(ctx context.Context, client *jira.Client, request mcp.Cal
 		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'comment' argument: %v", err)), nil
 	}
 
+	commentText, err = resolveMentions(ctx, client, commentText)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	comment := &jira.Comment{
 		Body: commentText,
 	}
@@ -348,36 +626,34 @@ func createIssueHandler(ctx context.Context, client *jira.Client, host string, r
 		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'description' argument: %v", err)), nil
 	}
 
-	assignee := request.GetString("assignee", "")
-
-	// Create a new issue
-	issue := &jira.Issue{
-		Fields: &jira.IssueFields{
-			Project: jira.Project{
-				Key: projectKey,
-			},
-			Summary:     title,
-			Description: description,
-			Type: jira.IssueType{
-				Name: issueType,
-			},
-		},
+	fields, err := fieldsArgument(request, "fields")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	// Add assignee if provided
-	if assignee != "" {
-		issue.Fields.Assignee = &jira.User{
-			Name: assignee,
-		}
+	issue, err := buildIssue(ctx, client, issueRequest{
+		Project:     projectKey,
+		IssueType:   issueType,
+		Summary:     title,
+		Description: description,
+		Assignee:    request.GetString("assignee", ""),
+		Fields:      fields,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	// Create the issue
-	createdIssue, _, err := client.Issue.Create(issue)
+	createdIssue, _, err := client.Issue.CreateWithContext(ctx, issue)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to create issue: %v", err)), nil
 	}
 
-	return mcp.NewToolResultText(fmt.Sprintf("Successfully created issue: %s (https://%s/browse/%s)", createdIssue.Key, host, createdIssue.Key)), nil
+	text := fmt.Sprintf("Successfully created issue: %s (https://%s/browse/%s)", createdIssue.Key, host, createdIssue.Key)
+	if watchers := request.GetStringSlice("watchers", nil); len(watchers) > 0 {
+		text += "\n" + strings.TrimSpace(addWatchers(ctx, client, createdIssue.Key, watchers))
+	}
+	return mcp.NewToolResultText(text), nil
 }
 
 func listIssuesHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -456,18 +732,309 @@ func assignIssueHandler(ctx context.Context, client *jira.Client, request mcp.Ca
 		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'assignee' argument: %v", err)), nil
 	}
 
-	// Create a User object with the assignee name
-	user := &jira.User{
-		Name: assignee,
+	user, err := resolveAssignee(ctx, client, assignee)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	// Update the assignee
-	_, err = client.Issue.UpdateAssigneeWithContext(ctx, issueKey, user)
-	if err != nil {
+	if err := setAssignee(ctx, client, issueKey, user); err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to assign issue: %v", err)), nil
 	}
 
-	return mcp.NewToolResultText(fmt.Sprintf("Successfully assigned issue %s to %s", issueKey, assignee)), nil
+	if user == nil {
+		return mcp.NewToolResultText(fmt.Sprintf("Successfully unassigned issue %s", issueKey)), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully assigned issue %s to %s", issueKey, userLabel(*user))), nil
+}
+
+// newMoveIssueTool returns the move_issue tool, which only has the delete_original argument when allowDelete is set
+func newMoveIssueTool(allowDelete bool) mcp.Tool {
+	options := []mcp.ToolOption{
+		mcp.WithDescription("Move a JIRA issue to another project or issue type, keeping its status where the target workflow has it. Jira Data Center cannot move an issue to another project, only copy it there with its sub-tasks, links, attachments and comments, which needs 'copy'; the copy gets a new key, and the original's history, worklogs, watchers and votes are not copied"),
+		mcp.WithString("issue_key",
+			mcp.Required(),
+			mcp.Description("JIRA issue key (e.g., 'PROJ-123')"),
+		),
+		mcp.WithString("project",
+			mcp.Description("Project key to move the issue to (defaults to the issue's project)"),
+		),
+		mcp.WithString("issue_type",
+			mcp.Description("Issue type to change the issue to (defaults to the issue's type)"),
+		),
+		mcp.WithString("status",
+			mcp.Description("Status in the target workflow (defaults to the status with the same name)"),
+		),
+		mcp.WithObject("fields",
+			mcp.Description("Required fields of the target keyed by display name or field ID, e.g. {\"Severity\": \"Minor\"}"),
+		),
+		mcp.WithBoolean("copy",
+			mcp.Description("On Jira Data Center, copy the issue to another project, as it cannot be moved there (default false)"),
+		),
+	}
+	if allowDelete {
+		options = append(options, mcp.WithBoolean("delete_original",
+			mcp.Description("Permanently delete the original and its sub-tasks after copying it. This cannot be undone (default false)"),
+		))
+	}
+	return mcp.NewTool("move_issue", options...)
+}
+
+func moveIssueHandler(ctx context.Context, client *jira.Client, host string, allowDelete bool, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	issueKey, err := request.RequireString("issue_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'issue_key' argument: %v", err)), nil
+	}
+	deleteOriginal := request.GetBool("delete_original", false)
+	if deleteOriginal && !allowDelete {
+		return mcp.NewToolResultError("Deleting the original is not allowed: the MCP server must be started with --allow-delete"), nil
+	}
+	copyIssue := request.GetBool("copy", false)
+	if deleteOriginal && !copyIssue {
+		return mcp.NewToolResultError("'delete_original' needs 'copy'"), nil
+	}
+
+	fields, err := fieldsArgument(request, "fields")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	opts := moveOptions{
+		Project:        request.GetString("project", ""),
+		IssueType:      request.GetString("issue_type", ""),
+		Status:         request.GetString("status", ""),
+		Fields:         fields,
+		Copy:           copyIssue,
+		DeleteOriginal: deleteOriginal,
+	}
+	if opts.Project == "" && opts.IssueType == "" {
+		return mcp.NewToolResultError("At least one of 'project' or 'issue_type' is required"), nil
+	}
+
+	result, err := moveIssue(ctx, client, issueKey, opts)
+	if errors.Is(err, errMoveNeedsCopy) {
+		return mcp.NewToolResultError(fmt.Sprintf("%v: set copy to copy it", err)), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(formatMoveResult(host, result)), nil
+}
+
+func deleteIssueHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	issueKey, err := request.RequireString("issue_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'issue_key' argument: %v", err)), nil
+	}
+	withSubtasks := request.GetBool("with_subtasks", false)
+
+	issue, err := checkDeletable(ctx, client, issueKey, withSubtasks)
+	if errors.Is(err, errHasSubtasks) {
+		return mcp.NewToolResultError(fmt.Sprintf("%v: set with_subtasks to delete them too", err)), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if err := deleteIssue(ctx, client, issueKey, withSubtasks); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText("Deleted:\n" + formatDeletion(issue)), nil
+}
+
+func watchIssueHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest, watch bool) (*mcp.CallToolResult, error) {
+	issueKey, err := request.RequireString("issue_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'issue_key' argument: %v", err)), nil
+	}
+
+	user, err := resolveUser(ctx, client, request.GetString("user", "me"))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if watch {
+		if err := addWatcher(ctx, client, issueKey, user); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("%s is now watching %s", user.DisplayName, issueKey)), nil
+	}
+
+	if err := removeWatcher(ctx, client, issueKey, user); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("%s is no longer watching %s", user.DisplayName, issueKey)), nil
+}
+
+func listWatchersHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	issueKey, err := request.RequireString("issue_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'issue_key' argument: %v", err)), nil
+	}
+
+	watchers, err := getWatchers(ctx, client, issueKey)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(formatWatchers(issueKey, watchers)), nil
+}
+
+func voteIssueHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest, vote bool) (*mcp.CallToolResult, error) {
+	issueKey, err := request.RequireString("issue_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'issue_key' argument: %v", err)), nil
+	}
+
+	if err := setVote(ctx, client, issueKey, vote); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if vote {
+		return mcp.NewToolResultText(fmt.Sprintf("Voted for %s", issueKey)), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Removed vote for %s", issueKey)), nil
+}
+
+func editListFieldHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest, field listField, arg string) (*mcp.CallToolResult, error) {
+	issueKey, err := request.RequireString("issue_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'issue_key' argument: %v", err)), nil
+	}
+
+	action, err := request.RequireString("action")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'action' argument: %v", err)), nil
+	}
+
+	values, err := request.RequireStringSlice(arg)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid '%s' argument: %v", arg, err)), nil
+	}
+
+	message, err := editListField(ctx, client, issueKey, field, action, values)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(message), nil
+}
+
+func listEpicsHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectKey, err := request.RequireString("project")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'project' argument: %v", err)), nil
+	}
+
+	epics, err := getEpics(ctx, client, projectKey, request.GetBool("all", false))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if len(epics) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No epics found in %s", projectKey)), nil
+	}
+	return mcp.NewToolResultText(formatEpics(epics)), nil
+}
+
+func getEpicIssuesHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	epicKey, err := request.RequireString("epic_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'epic_key' argument: %v", err)), nil
+	}
+
+	epic, issues, pointsFieldID, err := getEpicIssues(ctx, client, epicKey)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(formatEpicIssues(epic, issues, pointsFieldID)), nil
+}
+
+func editEpicHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	epicKey, err := request.RequireString("epic_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'epic_key' argument: %v", err)), nil
+	}
+
+	action, err := request.RequireString("action")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'action' argument: %v", err)), nil
+	}
+
+	keys, err := request.RequireStringSlice("issue_keys")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'issue_keys' argument: %v", err)), nil
+	}
+
+	message, err := editEpicIssues(ctx, client, action, epicKey, keys)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(message), nil
+}
+
+func listReadyIssuesHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	query, err := request.RequireString("query")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'query' argument: %v", err)), nil
+	}
+
+	plan, err := getReadyPlan(ctx, client, query)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(formatReadyPlan(plan)), nil
+}
+
+func getIssueHistoryHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	issueKey, err := request.RequireString("issue_key")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'issue_key' argument: %v", err)), nil
+	}
+
+	entries, err := getHistory(ctx, client, issueKey, request.GetStringSlice("fields", nil))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(formatHistory(issueKey, entries)), nil
+}
+
+func getStandupHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	since, err := parseSince(request.GetString("since", "yesterday"), time.Now())
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'since' argument: %v", err)), nil
+	}
+
+	r, err := getStandup(ctx, client, since)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(formatStandup(r)), nil
+}
+
+func findUserHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	query, err := request.RequireString("query")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'query' argument: %v", err)), nil
+	}
+
+	users, err := searchUsers(ctx, client, query)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if len(users) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No users found matching %q", query)), nil
+	}
+
+	return mcp.NewToolResultText(formatUsers(users)), nil
 }
 
 func addIssueToSprintHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -520,3 +1087,71 @@ func addIssueToSprintHandler(ctx context.Context, client *jira.Client, request m
 
 	return mcp.NewToolResultText(fmt.Sprintf("Successfully added issue %s to sprint %s (ID: %d)", issueKey, sprints.Values[0].Name, sprintID)), nil
 }
+
+func listProjectsHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projects, _, err := client.Project.GetListWithContext(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list projects: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatProjects(*projects)), nil
+}
+
+func listIssueTypesHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectKey, err := request.RequireString("project")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'project' argument: %v", err)), nil
+	}
+
+	project, _, err := client.Project.GetWithContext(ctx, projectKey)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get project: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatIssueTypes(project.IssueTypes)), nil
+}
+
+func listStatusesHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectKey, err := request.RequireString("project")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing or invalid 'project' argument: %v", err)), nil
+	}
+
+	statuses, err := getProjectStatuses(ctx, client, projectKey)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(formatStatuses(statuses)), nil
+}
+
+func listPrioritiesHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	priorities, _, err := client.Priority.GetListWithContext(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list priorities: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatPriorities(priorities)), nil
+}
+
+func listFieldsHandler(ctx context.Context, client *jira.Client, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	fields, _, err := client.Field.GetListWithContext(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list fields: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatFields(fields)), nil
+}
+
+// fieldsArgument reads an object argument of field values, where each value is a string, number, boolean or a list of them
+func fieldsArgument(request mcp.CallToolRequest, key string) (map[string][]string, error) {
+	raw, ok := request.GetArguments()[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	object, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("Invalid '%s' argument: expected an object", key)
+	}
+	return fieldStrings(object), nil
+}
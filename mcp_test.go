@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
 )
 
 func TestRun_MCPServer(t *testing.T) {
@@ -147,6 +150,11 @@ func TestRun_AddIssueToSprintMissingArgs(t *testing.T) {
 func TestRun_CreateIssueMissingArgs(t *testing.T) {
 	ctx := context.Background()
 
+	// Missing arguments are only prompted for on a terminal
+	oldIsInteractive := isInteractive
+	isInteractive = func() bool { return false }
+	defer func() { isInteractive = oldIsInteractive }()
+
 	// Test with no arguments
 	err := run(ctx, []string{"create-issue"})
 	if err == nil {
@@ -183,3 +191,341 @@ func TestRun_CreateIssueMissingArgs(t *testing.T) {
 		t.Errorf("Expected usage error, got: %v", err)
 	}
 }
+
+func TestRun_FindUserMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	err := run(ctx, []string{"find-user"})
+	if err == nil {
+		t.Error("Expected error for missing arguments, got nil")
+	}
+	if !strings.Contains(err.Error(), "usage: jira find-user") {
+		t.Errorf("Expected usage error, got: %v", err)
+	}
+}
+
+func TestRun_ProjectMetadataMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, command := range []string{"list-issue-types", "list-statuses"} {
+		err := run(ctx, []string{command})
+		if err == nil {
+			t.Errorf("Expected error for missing project in %s, got nil", command)
+			continue
+		}
+		if !strings.Contains(err.Error(), "usage: jira "+command+" <project>") {
+			t.Errorf("Expected usage error for %s, got: %v", command, err)
+		}
+	}
+}
+
+func TestRun_CreateIssueInvalidField(t *testing.T) {
+	ctx := context.Background()
+
+	err := run(ctx, []string{"create-issue", "--field", "no-equals-sign", "PROJ", "Task", "My Title", "Description"})
+	if err == nil {
+		t.Error("Expected error for invalid field flag, got nil")
+	}
+	if !strings.Contains(err.Error(), "usage: jira create-issue") {
+		t.Errorf("Expected usage error, got: %v", err)
+	}
+}
+
+func TestRun_CreateIssuesMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	err := run(ctx, []string{"create-issues"})
+	if err == nil {
+		t.Error("Expected error for missing arguments, got nil")
+	}
+	if !strings.Contains(err.Error(), "usage: jira create-issues") {
+		t.Errorf("Expected usage error, got: %v", err)
+	}
+}
+
+func TestRun_CloneIssueMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	err := run(ctx, []string{"clone-issue", "--with-links"})
+	if err == nil {
+		t.Error("Expected error for missing arguments, got nil")
+	}
+	if !strings.Contains(err.Error(), "usage: jira clone-issue") {
+		t.Errorf("Expected usage error, got: %v", err)
+	}
+}
+
+func TestRun_MoveIssueMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{{"move-issue"}, {"move-issue", "PROJ-1"}} {
+		err := run(ctx, args)
+		if err == nil {
+			t.Errorf("Expected error for %v, got nil", args)
+			continue
+		}
+		if !strings.Contains(err.Error(), "usage: jira move-issue") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_DeleteIssueMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	err := run(ctx, []string{"delete-issue", "--yes"})
+	if err == nil {
+		t.Error("Expected error for missing arguments, got nil")
+	}
+	if !strings.Contains(err.Error(), "usage: jira delete-issue") {
+		t.Errorf("Expected usage error, got: %v", err)
+	}
+}
+
+func TestRun_WatchersAndVotesMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, command := range []string{"watch", "unwatch", "list-watchers", "vote", "unvote"} {
+		err := run(ctx, []string{command})
+		if err == nil {
+			t.Errorf("Expected error for %s without arguments, got nil", command)
+			continue
+		}
+		if !strings.Contains(err.Error(), "usage: jira "+command) {
+			t.Errorf("Expected usage error for %s, got: %v", command, err)
+		}
+	}
+}
+
+func TestRun_ListFieldMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{{"label"}, {"component", "add", "PROJ-1"}, {"fix-version", "set", "PROJ-1", "1.0"}} {
+		err := run(ctx, args)
+		if err == nil {
+			t.Errorf("Expected error for %v, got nil", args)
+			continue
+		}
+		if !strings.Contains(err.Error(), "usage: jira "+args[0]+" add|remove") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_VersionMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"version"}, "usage: jira version"},
+		{[]string{"version", "create", "PROJ"}, "usage: jira version"},
+		{[]string{"version", "delete", "PROJ", "1.0"}, "usage: jira version"},
+		{[]string{"release-notes", "PROJ"}, "usage: jira release-notes"},
+		{[]string{"release-notes", "PROJ", "1.0", "--format", "pdf"}, "usage: jira release-notes"},
+	}
+	for _, tt := range tests {
+		err := run(ctx, tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected usage error for %v, got: %v", tt.args, err)
+		}
+	}
+}
+
+func TestRun_EpicMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"epics"}, "usage: jira epics"},
+		{[]string{"epic-issues"}, "usage: jira epic-issues"},
+		{[]string{"epic", "add", "PROJ-100"}, "usage: jira epic"},
+		{[]string{"epic", "move", "PROJ-100", "PROJ-1"}, "usage: jira epic"},
+	}
+	for _, tt := range tests {
+		err := run(ctx, tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected usage error for %v, got: %v", tt.args, err)
+		}
+	}
+}
+
+func TestRun_TreeMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{
+		{"tree"},
+		{"tree", "PROJ-100", "--output", "yaml"},
+	} {
+		err := run(ctx, args)
+		if err == nil || !strings.Contains(err.Error(), "usage: jira tree") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_GraphMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{
+		{"graph"},
+		{"graph", "PROJ-1", "--format", "svg"},
+		{"graph", "PROJ-1", "--depth", "-1"},
+	} {
+		err := run(ctx, args)
+		if err == nil || !strings.Contains(err.Error(), "usage: jira graph") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_ReadyMissingArgs(t *testing.T) {
+	err := run(context.Background(), []string{"ready"})
+	if err == nil || !strings.Contains(err.Error(), "usage: jira ready") {
+		t.Errorf("Expected usage error, got: %v", err)
+	}
+}
+
+func TestRun_HistoryMissingArgs(t *testing.T) {
+	err := run(context.Background(), []string{"history", "--field", "status"})
+	if err == nil || !strings.Contains(err.Error(), "usage: jira history") {
+		t.Errorf("Expected usage error, got: %v", err)
+	}
+}
+
+func TestRun_MetricsMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{
+		{"metrics"},
+		{"metrics", "throughput", "project = PROJ"},
+		{"metrics", "cycle-time"},
+		{"metrics", "cycle-time", "project = PROJ", "--output", "xml"},
+	} {
+		err := run(ctx, args)
+		if err == nil || !strings.Contains(err.Error(), "usage: jira metrics") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_SprintReportMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"sprint"}, "usage: jira sprint report"},
+		{[]string{"sprint", "report"}, "usage: jira sprint report"},
+		{[]string{"sprint", "start", "--board", "1"}, "usage: jira sprint report"},
+		{[]string{"velocity"}, "usage: jira velocity"},
+		{[]string{"velocity", "--board", "1", "--last", "0"}, "usage: jira velocity"},
+	}
+	for _, tt := range tests {
+		err := run(ctx, tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected usage error for %v, got: %v", tt.args, err)
+		}
+	}
+}
+
+func TestRun_BurndownMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{
+		{"burndown"},
+		{"burndown", "--board", "1", "--output", "png"},
+	} {
+		err := run(ctx, args)
+		if err == nil || !strings.Contains(err.Error(), "usage: jira burndown") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_ForecastMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{
+		{"forecast"},
+		{"forecast", "project = PROJ", "--history", "3 months"},
+		{"forecast", "project = PROJ", "--runs", "0"},
+	} {
+		err := run(ctx, args)
+		if err == nil || !strings.Contains(err.Error(), "usage: jira forecast") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_StandupMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{
+		{"standup", "PROJ-1"},
+		{"standup", "--since", "last week"},
+	} {
+		err := run(ctx, args)
+		if err == nil || !strings.Contains(err.Error(), "usage: jira standup") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_InboxMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{
+		{"inbox", "PROJ-1"},
+		{"inbox", "--unread"},
+	} {
+		err := run(ctx, args)
+		if err == nil || !strings.Contains(err.Error(), "usage: jira inbox") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestRun_WatchJQLMissingArgs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, args := range [][]string{
+		{"watch-jql"},
+		{"watch-jql", "project = OPS", "--interval", "10ms"},
+		{"watch-jql", "project = OPS", "--output", "xml"},
+	} {
+		err := run(ctx, args)
+		if err == nil || !strings.Contains(err.Error(), "usage: jira watch-jql") {
+			t.Errorf("Expected usage error for %v, got: %v", args, err)
+		}
+	}
+}
+
+func TestMoveIssueTool_DeleteOriginalNeedsAllowDelete(t *testing.T) {
+	if _, ok := newMoveIssueTool(false).InputSchema.Properties["delete_original"]; ok {
+		t.Error("Expected no delete_original argument without --allow-delete")
+	}
+	if _, ok := newMoveIssueTool(true).InputSchema.Properties["delete_original"]; !ok {
+		t.Error("Expected a delete_original argument with --allow-delete")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request to %s", r.URL.Path)
+	})
+	c := newTestClient(t, "Server", mux)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"issue_key": "PROJ-1", "project": "OTHER", "copy": true, "delete_original": true}
+	result, err := moveIssueHandler(context.Background(), c, "jira.example.com", false, request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "--allow-delete") {
+		t.Errorf("Expected delete_original to be rejected, got %+v", result)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+var (
+	// mentionPattern matches "@handle" or "@email" at the start of the text or after whitespace or
+	// punctuation, so that a plain email address such as "alice@example.com" is left alone
+	mentionPattern = regexp.MustCompile(`(^|[^\w@.\-\[~])@(\w(?:[\w.+\-]*\w)?(?:@\w(?:[\w.\-]*\w)?)?)`)
+
+	// verbatimPattern matches wiki markup whose content is shown as-is, e.g. "@Override" in a code block
+	verbatimPattern = regexp.MustCompile(`(?s)\{code[^}]*\}.*?\{code\}|\{noformat[^}]*\}.*?\{noformat\}|\{\{.*?\}\}`)
+)
+
+// resolveMentions replaces "@handle" tokens in text with Jira mention markup, "[~username]" on
+// Server/Data Center and "[~accountid:id]" on Cloud. Each handle is looked up with a user search
+// and an error listing the candidates is returned if it does not identify exactly one user.
+func resolveMentions(ctx context.Context, client *jira.Client, text string) (string, error) {
+	var handles []string
+	seen := map[string]bool{}
+	forEachMention(text, func(handle string) string {
+		if !seen[handle] {
+			seen[handle] = true
+			handles = append(handles, handle)
+		}
+		return ""
+	})
+	if len(handles) == 0 {
+		return text, nil
+	}
+
+	cloud := isCloud(ctx, client)
+	markup := map[string]string{}
+	for _, handle := range handles {
+		users, err := searchUsers(ctx, client, handle)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve @%s: %w", handle, err)
+		}
+		user, err := matchUser(handle, users)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve @%s: %w", handle, err)
+		}
+		markup[handle] = mentionMarkup(*user, cloud)
+	}
+
+	return forEachMention(text, func(handle string) string {
+		return markup[handle]
+	}), nil
+}
+
+// forEachMention calls fn for every mention outside verbatim blocks and replaces the mention with its result
+func forEachMention(text string, fn func(handle string) string) string {
+	replace := func(s string) string {
+		return mentionPattern.ReplaceAllStringFunc(s, func(match string) string {
+			groups := mentionPattern.FindStringSubmatch(match)
+			return groups[1] + fn(groups[2])
+		})
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, loc := range verbatimPattern.FindAllStringIndex(text, -1) {
+		sb.WriteString(replace(text[last:loc[0]]))
+		sb.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	sb.WriteString(replace(text[last:]))
+	return sb.String()
+}
+
+// mentionMarkup returns the wiki markup that mentions the user
+func mentionMarkup(user jira.User, cloud bool) string {
+	if cloud {
+		return fmt.Sprintf("[~accountid:%s]", user.AccountID)
+	}
+	return fmt.Sprintf("[~%s]", user.Name)
+}
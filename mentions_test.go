@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// newTestClient returns a JIRA client talking to a fake server backed by mux
+func newTestClient(t *testing.T, deploymentType string, mux *http.ServeMux) *jira.Client {
+	t.Helper()
+	mux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(serverInfo{DeploymentType: deploymentType})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	c, err := jira.NewClient(nil, srv.URL)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+// userSearchHandler serves user searches from a fixed list of users, matching on the username prefix
+func userSearchHandler(users []jira.User) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("username")
+		if query == "" {
+			query = r.URL.Query().Get("query")
+		}
+		var result []jira.User
+		for _, u := range users {
+			if strings.HasPrefix(strings.ToLower(u.Name), strings.ToLower(query)) ||
+				strings.HasPrefix(strings.ToLower(u.EmailAddress), strings.ToLower(query)) ||
+				strings.HasPrefix(strings.ToLower(u.DisplayName), strings.ToLower(query)) {
+				result = append(result, u)
+			}
+		}
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+var testUsers = []jira.User{
+	{Name: "alice", AccountID: "acc-alice", DisplayName: "Alice Smith", EmailAddress: "alice@example.com"},
+	{Name: "alice.jones", AccountID: "acc-alice-jones", DisplayName: "Alice Jones", EmailAddress: "ajones@example.com"},
+	{Name: "bob", AccountID: "acc-bob", DisplayName: "Bob Brown", EmailAddress: "bob@example.com"},
+	{Name: "carol", AccountID: "acc-carol", DisplayName: "Carol White", EmailAddress: "carol@example.com"},
+	{Name: "carol2", AccountID: "acc-carol2", DisplayName: "Carol Black", EmailAddress: "carol.black@example.com"},
+}
+
+func TestResolveMentions_Server(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/user/search", userSearchHandler(testUsers))
+	c := newTestClient(t, "Server", mux)
+
+	got, err := resolveMentions(context.Background(), c, "Thanks @bob, cc @alice.jones and @carol@example.com.")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := "Thanks [~bob], cc [~alice.jones] and [~carol]."
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestResolveMentions_Cloud(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/user/search", userSearchHandler(testUsers))
+	c := newTestClient(t, "Cloud", mux)
+
+	got, err := resolveMentions(context.Background(), c, "@bob please review")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := "[~accountid:acc-bob] please review"
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestResolveMentions_IgnoresEmailsAndCode(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected user search: %s", r.URL)
+	})
+	c := newTestClient(t, "Server", mux)
+
+	text := "Mail bob@example.com\n{code:java}\n@Override\n{code}\nand {{@Test}}"
+	got, err := resolveMentions(context.Background(), c, text)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != text {
+		t.Errorf("Expected text to be unchanged, got %q", got)
+	}
+}
+
+func TestResolveMentions_Ambiguous(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/user/search", userSearchHandler(testUsers))
+	c := newTestClient(t, "Server", mux)
+
+	_, err := resolveMentions(context.Background(), c, "ping @car")
+	if err == nil {
+		t.Fatal("Expected error for ambiguous mention, got nil")
+	}
+	if !strings.Contains(err.Error(), "Carol White (carol") || !strings.Contains(err.Error(), "Carol Black (carol2") {
+		t.Errorf("Expected suggestions in error, got: %v", err)
+	}
+}
+
+func TestResolveMentions_ExactMatchWins(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/user/search", userSearchHandler(testUsers))
+	c := newTestClient(t, "Server", mux)
+
+	// "alice" is a prefix of both alice and alice.jones, but an exact username match
+	got, err := resolveMentions(context.Background(), c, "@alice")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "[~alice]" {
+		t.Errorf("Expected %q, got %q", "[~alice]", got)
+	}
+}
+
+func TestResolveMentions_NotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/user/search", userSearchHandler(testUsers))
+	c := newTestClient(t, "Server", mux)
+
+	_, err := resolveMentions(context.Background(), c, "@nobody")
+	if err == nil || !strings.Contains(err.Error(), `no user found matching "nobody"`) {
+		t.Errorf("Expected not found error, got: %v", err)
+	}
+}
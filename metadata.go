@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// issueTypeStatuses is the set of statuses in the workflow of one issue type
+type issueTypeStatuses struct {
+	Name     string        `json:"name"`
+	Subtask  bool          `json:"subtask"`
+	Statuses []jira.Status `json:"statuses"`
+}
+
+// getProjectStatuses returns the statuses available to each issue type of a project
+func getProjectStatuses(ctx context.Context, client *jira.Client, projectKey string) ([]issueTypeStatuses, error) {
+	req, err := client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("rest/api/2/project/%s/statuses", projectKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []issueTypeStatuses
+	if _, err := client.Do(req, &statuses); err != nil {
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+	return statuses, nil
+}
+
+// formatProjects formats projects as a table of key and name
+func formatProjects(projects jira.ProjectList) string {
+	sort.Slice(projects, func(i, j int) bool { return projects[i].Key < projects[j].Key })
+
+	var sb strings.Builder
+	for _, project := range projects {
+		fmt.Fprintf(&sb, "%-15s %s\n", project.Key, project.Name)
+	}
+	return sb.String()
+}
+
+// formatIssueTypes formats issue types one per line, marking sub-task types
+func formatIssueTypes(issueTypes []jira.IssueType) string {
+	var sb strings.Builder
+	for _, issueType := range issueTypes {
+		if issueType.Subtask {
+			fmt.Fprintf(&sb, "%s (sub-task)\n", issueType.Name)
+		} else {
+			fmt.Fprintf(&sb, "%s\n", issueType.Name)
+		}
+	}
+	return sb.String()
+}
+
+// formatStatuses formats the statuses of each issue type along with their status category
+func formatStatuses(statuses []issueTypeStatuses) string {
+	var sb strings.Builder
+	for i, issueType := range statuses {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "%s:\n", issueType.Name)
+		for _, status := range issueType.Statuses {
+			fmt.Fprintf(&sb, "  %-30s %s\n", status.Name, status.StatusCategory.Name)
+		}
+	}
+	return sb.String()
+}
+
+// formatPriorities formats priorities one per line, in the order Jira ranks them
+func formatPriorities(priorities []jira.Priority) string {
+	var sb strings.Builder
+	for _, priority := range priorities {
+		fmt.Fprintf(&sb, "%s\n", priority.Name)
+	}
+	return sb.String()
+}
+
+// formatFields formats fields as a table of ID, name and type, sorted by name
+func formatFields(fields []jira.Field) string {
+	sort.Slice(fields, func(i, j int) bool { return strings.ToLower(fields[i].Name) < strings.ToLower(fields[j].Name) })
+
+	var sb strings.Builder
+	for _, field := range fields {
+		fieldType := field.Schema.Type
+		if field.Schema.Items != "" {
+			fieldType += "<" + field.Schema.Items + ">"
+		}
+		fmt.Fprintf(&sb, "%-25s %-40s %s\n", field.ID, field.Name, fieldType)
+	}
+	return sb.String()
+}
+
+// listProjects lists the projects visible to the current user
+func listProjects(ctx context.Context) error {
+	projects, _, err := client.Project.GetListWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	fmt.Print(formatProjects(*projects))
+	return nil
+}
+
+// listIssueTypes lists the issue types available in a project
+func listIssueTypes(ctx context.Context, projectKey string) error {
+	project, _, err := client.Project.GetWithContext(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	fmt.Print(formatIssueTypes(project.IssueTypes))
+	return nil
+}
+
+// listStatuses lists the statuses available to each issue type in a project
+func listStatuses(ctx context.Context, projectKey string) error {
+	statuses, err := getProjectStatuses(ctx, client, projectKey)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatStatuses(statuses))
+	return nil
+}
+
+// listPriorities lists the priorities an issue can have
+func listPriorities(ctx context.Context) error {
+	priorities, _, err := client.Priority.GetListWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list priorities: %w", err)
+	}
+
+	fmt.Print(formatPriorities(priorities))
+	return nil
+}
+
+// listFields lists all system and custom fields, including the customfield IDs
+func listFields(ctx context.Context) error {
+	fields, _, err := client.Field.GetListWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list fields: %w", err)
+	}
+
+	fmt.Print(formatFields(fields))
+	return nil
+}
+
+// findFieldID returns the ID of the first field that matches, described by what for the error message
+func findFieldID(ctx context.Context, client *jira.Client, what string, match func(jira.Field) bool) (string, error) {
+	fields, _, err := client.Field.GetListWithContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list fields: %w", err)
+	}
+	for _, field := range fields {
+		if match(field) {
+			return field.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no %s field found", what)
+}
+
+// epicLinkFieldID returns the ID of the "Epic Link" custom field used by Server/Data Center
+func epicLinkFieldID(ctx context.Context, client *jira.Client) (string, error) {
+	return findFieldID(ctx, client, "Epic Link", func(field jira.Field) bool {
+		return field.Schema.Custom == "com.pyxis.greenhopper.jira:gh-epic-link"
+	})
+}
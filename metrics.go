@@ -0,0 +1,358 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// statusChange is a transition of an issue from one status to another
+type statusChange struct {
+	At       time.Time
+	From, To string
+}
+
+// statusChanges returns the status transitions in an issue's changelog, oldest first
+func statusChanges(issue jira.Issue) ([]statusChange, error) {
+	if issue.Changelog == nil {
+		return nil, nil
+	}
+	var changes []statusChange
+	for _, history := range issue.Changelog.Histories {
+		for _, item := range history.Items {
+			if item.Field != "status" {
+				continue
+			}
+			at, err := time.Parse(jiraTimeLayout, history.Created)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected time %q in changelog of %s: %w", history.Created, issue.Key, err)
+			}
+			changes = append(changes, statusChange{At: at, From: item.FromString, To: item.ToString})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].At.Before(changes[j].At)
+	})
+	return changes, nil
+}
+
+// issueMetrics is how long an issue took and spent in each status. Lead time runs from creation to
+// resolution, and cycle time from first entering the from-status (or creation, if it was created in it) to
+// last entering the to-status; each is zero if the issue has not got that far, or has since left the to-status.
+type issueMetrics struct {
+	Key          string
+	Summary      string
+	Status       string
+	LeadTime     time.Duration
+	CycleTime    time.Duration
+	TimeInStatus map[string]time.Duration
+}
+
+// computeMetrics works out the metrics of an issue from its changelog, counting time in the current
+// status up to now
+func computeMetrics(issue jira.Issue, fromStatus, toStatus string, now time.Time) (issueMetrics, error) {
+	f := issue.Fields
+	m := issueMetrics{Key: issue.Key, Summary: f.Summary, TimeInStatus: map[string]time.Duration{}}
+	if f.Status != nil {
+		m.Status = f.Status.Name
+	}
+
+	changes, err := statusChanges(issue)
+	if err != nil {
+		return m, err
+	}
+
+	created := time.Time(f.Created)
+	if resolved := time.Time(f.Resolutiondate); !resolved.IsZero() && !created.IsZero() {
+		m.LeadTime = resolved.Sub(created)
+	}
+
+	status, since := m.Status, created
+	if len(changes) > 0 {
+		status = changes[0].From
+	}
+	// An issue created in the from-status started when it was created
+	var started, finished time.Time
+	if strings.EqualFold(status, fromStatus) {
+		started = created
+	}
+	for _, change := range changes {
+		if !since.IsZero() {
+			m.TimeInStatus[status] += change.At.Sub(since)
+		}
+		status, since = change.To, change.At
+		if strings.EqualFold(change.To, fromStatus) && started.IsZero() {
+			started = change.At
+		}
+		// An issue that left the to-status again, e.g. reopened, has not finished
+		if !strings.EqualFold(change.To, toStatus) {
+			finished = time.Time{}
+		} else if !started.IsZero() {
+			finished = change.At
+		}
+	}
+	if !since.IsZero() && now.After(since) {
+		m.TimeInStatus[status] += now.Sub(since)
+	}
+	if !finished.IsZero() {
+		m.CycleTime = finished.Sub(started)
+	}
+	return m, nil
+}
+
+// durationStats summarizes durations with their mean and percentiles
+type durationStats struct {
+	Count               int
+	Mean, P50, P85, P95 time.Duration
+}
+
+// summarizeDurations returns the count, mean and 50th, 85th and 95th percentiles (nearest rank) of the
+// durations that are not zero
+func summarizeDurations(durations []time.Duration) durationStats {
+	var ds []time.Duration
+	var total time.Duration
+	for _, d := range durations {
+		if d > 0 {
+			ds = append(ds, d)
+			total += d
+		}
+	}
+	if len(ds) == 0 {
+		return durationStats{}
+	}
+	sort.Slice(ds, func(i, j int) bool { return ds[i] < ds[j] })
+	percentile := func(p float64) time.Duration {
+		return ds[percentileIndex(p, len(ds))]
+	}
+	return durationStats{
+		Count: len(ds),
+		Mean:  total / time.Duration(len(ds)),
+		P50:   percentile(50),
+		P85:   percentile(85),
+		P95:   percentile(95),
+	}
+}
+
+// percentileIndex returns the index of a percentile (nearest rank) in n sorted values
+func percentileIndex(p float64, n int) int {
+	return int(math.Ceil(p/100*float64(n))) - 1
+}
+
+// days returns a duration in days, rounded to one decimal place
+func days(d time.Duration) float64 {
+	return math.Round(d.Hours()/24*10) / 10
+}
+
+// formatDays formats a duration in days, or "-" if it is zero
+func formatDays(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1fd", days(d))
+}
+
+// getMetrics computes the metrics of the issues matching JQL, and returns the statuses in workflow order
+func getMetrics(ctx context.Context, client *jira.Client, jql, fromStatus, toStatus string) ([]issueMetrics, []string, error) {
+	issues, err := searchIssues(ctx, client, jql, &jira.SearchOptions{
+		Fields: []string{"summary", "status", "created", "resolutiondate"},
+		Expand: "changelog",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := completeChangelogs(ctx, client, issues); err != nil {
+		return nil, nil, err
+	}
+
+	// Statuses are ordered by when an issue first entered them, which follows the workflow for most issues
+	now := time.Now()
+	var metrics []issueMetrics
+	var entered []statusChange
+	for _, issue := range issues {
+		m, err := computeMetrics(issue, fromStatus, toStatus, now)
+		if err != nil {
+			return nil, nil, err
+		}
+		metrics = append(metrics, m)
+
+		changes, _ := statusChanges(issue)
+		initial := m.Status
+		if len(changes) > 0 {
+			initial = changes[0].From
+		}
+		entered = append(entered, statusChange{At: time.Time(issue.Fields.Created), To: initial})
+		entered = append(entered, changes...)
+	}
+	sort.SliceStable(entered, func(i, j int) bool { return entered[i].At.Before(entered[j].At) })
+
+	var statuses []string
+	seen := map[string]bool{}
+	for _, change := range entered {
+		if !seen[change.To] {
+			seen[change.To] = true
+			statuses = append(statuses, change.To)
+		}
+	}
+	return metrics, statuses, nil
+}
+
+// formatCycleTime formats the cycle and lead time statistics, the average time in each status, and each issue's times
+func formatCycleTime(metrics []issueMetrics, statuses []string, fromStatus, toStatus string) string {
+	if len(metrics) == 0 {
+		return "No issues found\n"
+	}
+
+	var cycle, lead []time.Duration
+	for _, m := range metrics {
+		cycle = append(cycle, m.CycleTime)
+		lead = append(lead, m.LeadTime)
+	}
+	cycleStats, leadStats := summarizeDurations(cycle), summarizeDurations(lead)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Issues: %d (%d went from %s to %s, %d resolved)\n\n", len(metrics), cycleStats.Count, fromStatus, toStatus, leadStats.Count)
+	fmt.Fprintf(&sb, "%-12s %-6s %-8s %-8s %-8s %s\n", "", "Count", "Mean", "50%", "85%", "95%")
+	for _, row := range []struct {
+		name  string
+		stats durationStats
+	}{{"Cycle time", cycleStats}, {"Lead time", leadStats}} {
+		s := row.stats
+		fmt.Fprintf(&sb, "%-12s %-6d %-8s %-8s %-8s %s\n", row.name, s.Count, formatDays(s.Mean), formatDays(s.P50), formatDays(s.P85), formatDays(s.P95))
+	}
+
+	sb.WriteString("\nAverage time in status:\n")
+	for _, status := range statuses {
+		var total time.Duration
+		for _, m := range metrics {
+			total += m.TimeInStatus[status]
+		}
+		fmt.Fprintf(&sb, "%-20s %s\n", status, formatDays(total/time.Duration(len(metrics))))
+	}
+
+	sb.WriteString("\n")
+	fmt.Fprintf(&sb, "%-15s %-8s %-8s %s\n", "Issue", "Cycle", "Lead", "Summary")
+	for _, m := range metrics {
+		fmt.Fprintf(&sb, "%-15s %-8s %-8s %s\n", m.Key, formatDays(m.CycleTime), formatDays(m.LeadTime), m.Summary)
+	}
+	return sb.String()
+}
+
+// writeCycleTimeCSV writes one row per issue with its times in days, and a column for each status
+func writeCycleTimeCSV(w io.Writer, metrics []issueMetrics, statuses []string) error {
+	cw := csv.NewWriter(w)
+	header := []string{"key", "summary", "status", "cycle_time_days", "lead_time_days"}
+	for _, status := range statuses {
+		header = append(header, status+" (days)")
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	optional := func(d time.Duration) string {
+		if d == 0 {
+			return ""
+		}
+		return fmt.Sprint(days(d))
+	}
+	for _, m := range metrics {
+		row := []string{m.Key, m.Summary, m.Status, optional(m.CycleTime), optional(m.LeadTime)}
+		for _, status := range statuses {
+			row = append(row, fmt.Sprint(days(m.TimeInStatus[status])))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// cycleTimeJSON is the JSON output of the cycle time metrics, with times in days
+type cycleTimeJSON struct {
+	FromStatus string             `json:"fromStatus"`
+	ToStatus   string             `json:"toStatus"`
+	CycleTime  statsJSON          `json:"cycleTime"`
+	LeadTime   statsJSON          `json:"leadTime"`
+	Issues     []issueMetricsJSON `json:"issues"`
+}
+
+type statsJSON struct {
+	Count int     `json:"count"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P85   float64 `json:"p85"`
+	P95   float64 `json:"p95"`
+}
+
+type issueMetricsJSON struct {
+	Key          string             `json:"key"`
+	Summary      string             `json:"summary"`
+	Status       string             `json:"status"`
+	CycleTime    *float64           `json:"cycleTime"`
+	LeadTime     *float64           `json:"leadTime"`
+	TimeInStatus map[string]float64 `json:"timeInStatus"`
+}
+
+// writeCycleTimeJSON writes the statistics and each issue's metrics as JSON
+func writeCycleTimeJSON(w io.Writer, metrics []issueMetrics, fromStatus, toStatus string) error {
+	toJSON := func(s durationStats) statsJSON {
+		return statsJSON{Count: s.Count, Mean: days(s.Mean), P50: days(s.P50), P85: days(s.P85), P95: days(s.P95)}
+	}
+	optional := func(d time.Duration) *float64 {
+		if d == 0 {
+			return nil
+		}
+		v := days(d)
+		return &v
+	}
+
+	out := cycleTimeJSON{FromStatus: fromStatus, ToStatus: toStatus, Issues: []issueMetricsJSON{}}
+	var cycle, lead []time.Duration
+	for _, m := range metrics {
+		cycle = append(cycle, m.CycleTime)
+		lead = append(lead, m.LeadTime)
+		inStatus := map[string]float64{}
+		for status, d := range m.TimeInStatus {
+			inStatus[status] = days(d)
+		}
+		out.Issues = append(out.Issues, issueMetricsJSON{
+			Key:          m.Key,
+			Summary:      m.Summary,
+			Status:       m.Status,
+			CycleTime:    optional(m.CycleTime),
+			LeadTime:     optional(m.LeadTime),
+			TimeInStatus: inStatus,
+		})
+	}
+	out.CycleTime, out.LeadTime = toJSON(summarizeDurations(cycle)), toJSON(summarizeDurations(lead))
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// printCycleTime prints the cycle time metrics of the issues matching JQL as text, csv or json
+func printCycleTime(ctx context.Context, jql, fromStatus, toStatus, output string) error {
+	metrics, statuses, err := getMetrics(ctx, client, jql, fromStatus, toStatus)
+	if err != nil {
+		return err
+	}
+
+	switch output {
+	case "csv":
+		return writeCycleTimeCSV(os.Stdout, metrics, statuses)
+	case "json":
+		return writeCycleTimeJSON(os.Stdout, metrics, fromStatus, toStatus)
+	}
+	fmt.Print(formatCycleTime(metrics, statuses, fromStatus, toStatus))
+	return nil
+}
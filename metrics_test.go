@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestComputeMetrics(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, 10, day, hour, 0, 0, 0, time.UTC)
+	}
+	change := func(when time.Time, from, to string) jira.ChangelogHistory {
+		return jira.ChangelogHistory{Created: when.Format(jiraTimeLayout), Items: []jira.ChangelogItems{
+			{Field: "status", FromString: from, ToString: to},
+			{Field: "assignee", ToString: "John Doe"},
+		}}
+	}
+	issue := testIssue("PROJ-1", "Story", "Test")
+	issue.Fields.Status = &jira.Status{Name: "Done"}
+	issue.Fields.Created = jira.Time(at(1, 0))
+	issue.Fields.Resolutiondate = jira.Time(at(8, 0))
+	issue.Changelog = &jira.Changelog{Histories: []jira.ChangelogHistory{
+		change(at(6, 0), "In Progress", "In Review"),
+		change(at(2, 0), "To Do", "In Progress"),
+		change(at(4, 0), "In Progress", "To Do"),
+		change(at(5, 0), "To Do", "In Progress"),
+		change(at(8, 0), "In Review", "Done"),
+	}}
+
+	m, err := computeMetrics(issue, "in progress", "done", at(10, 0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := 6 * 24 * time.Hour; m.CycleTime != want {
+		t.Errorf("Expected cycle time %v, got %v", want, m.CycleTime)
+	}
+	if want := 7 * 24 * time.Hour; m.LeadTime != want {
+		t.Errorf("Expected lead time %v, got %v", want, m.LeadTime)
+	}
+	for status, want := range map[string]int{"To Do": 2, "In Progress": 3, "In Review": 2, "Done": 2} {
+		if got := m.TimeInStatus[status]; got != time.Duration(want)*24*time.Hour {
+			t.Errorf("Expected %d days in %s, got %v", want, status, got)
+		}
+	}
+}
+
+func TestComputeMetrics_CreatedInFromStatusAndReopened(t *testing.T) {
+	at := func(day int) time.Time {
+		return time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC)
+	}
+	change := func(when time.Time, from, to string) jira.ChangelogHistory {
+		return jira.ChangelogHistory{Created: when.Format(jiraTimeLayout), Items: []jira.ChangelogItems{
+			{Field: "status", FromString: from, ToString: to},
+		}}
+	}
+
+	// Created in Open, the from-status, and done three days later
+	issue := testIssue("PROJ-1", "Story", "Test")
+	issue.Fields.Status = &jira.Status{Name: "Done"}
+	issue.Fields.Created = jira.Time(at(1))
+	issue.Changelog = &jira.Changelog{Histories: []jira.ChangelogHistory{change(at(4), "Open", "Done")}}
+	m, err := computeMetrics(issue, "Open", "Done", at(10))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := 3 * 24 * time.Hour; m.CycleTime != want {
+		t.Errorf("Expected cycle time %v for an issue created in the from-status, got %v", want, m.CycleTime)
+	}
+
+	// Done, then reopened and still open
+	issue.Fields.Status = &jira.Status{Name: "Open"}
+	issue.Changelog.Histories = append(issue.Changelog.Histories, change(at(6), "Done", "Open"))
+	if m, _ = computeMetrics(issue, "Open", "Done", at(10)); m.CycleTime != 0 {
+		t.Errorf("Expected no cycle time for a reopened issue, got %v", m.CycleTime)
+	}
+
+	// Done again after being reopened
+	issue.Fields.Status = &jira.Status{Name: "Done"}
+	issue.Changelog.Histories = append(issue.Changelog.Histories, change(at(8), "Open", "Done"))
+	if m, _ = computeMetrics(issue, "Open", "Done", at(10)); m.CycleTime != 7*24*time.Hour {
+		t.Errorf("Expected cycle time to the last time it was done, got %v", m.CycleTime)
+	}
+}
+
+func TestSummarizeDurations(t *testing.T) {
+	var ds []time.Duration
+	for i := 1; i <= 20; i++ {
+		ds = append(ds, time.Duration(i)*time.Hour)
+	}
+	ds = append(ds, 0)
+
+	got := summarizeDurations(ds)
+	want := durationStats{Count: 20, Mean: 630 * time.Minute, P50: 10 * time.Hour, P85: 17 * time.Hour, P95: 19 * time.Hour}
+	if got != want {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+}
+
+func TestCycleTimeOutput(t *testing.T) {
+	metrics := []issueMetrics{
+		{Key: "PROJ-1", Summary: "First, with a comma", Status: "Done", CycleTime: 36 * time.Hour, LeadTime: 72 * time.Hour,
+			TimeInStatus: map[string]time.Duration{"To Do": 36 * time.Hour, "In Progress": 36 * time.Hour}},
+		{Key: "PROJ-2", Summary: "Second", Status: "To Do", TimeInStatus: map[string]time.Duration{"To Do": 24 * time.Hour}},
+	}
+	statuses := []string{"To Do", "In Progress"}
+
+	var csvOut bytes.Buffer
+	if err := writeCycleTimeCSV(&csvOut, metrics, statuses); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantCSV := `key,summary,status,cycle_time_days,lead_time_days,To Do (days),In Progress (days)
+PROJ-1,"First, with a comma",Done,1.5,3,1.5,1.5
+PROJ-2,Second,To Do,,,1,0
+`
+	if csvOut.String() != wantCSV {
+		t.Errorf("Expected CSV:\n%s\ngot:\n%s", wantCSV, csvOut.String())
+	}
+
+	var jsonOut bytes.Buffer
+	if err := writeCycleTimeJSON(&jsonOut, metrics, "In Progress", "Done"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded cycleTimeJSON
+	if err := json.Unmarshal(jsonOut.Bytes(), &decoded); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if decoded.CycleTime.Count != 1 || decoded.CycleTime.P50 != 1.5 || decoded.Issues[1].CycleTime != nil {
+		t.Errorf("Unexpected JSON: %s", jsonOut.String())
+	}
+
+	text := formatCycleTime(metrics, statuses, "In Progress", "Done")
+	for _, want := range []string{
+		"Issues: 2 (1 went from In Progress to Done, 1 resolved)",
+		"Cycle time   1      1.5d     1.5d     1.5d     1.5d",
+		"To Do                1.3d",
+		"PROJ-2          -        -        Second",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected text to contain %q, got:\n%s", want, text)
+		}
+	}
+}
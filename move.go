@@ -0,0 +1,473 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// moveOptions says where move-issue moves an issue to
+type moveOptions struct {
+	// Project is the target project, the issue's own if empty
+	Project string
+	// IssueType is the target issue type, the issue's own if empty
+	IssueType string
+	// Status is the status in the target workflow, the status with the same name as the issue's if empty
+	Status string
+	// Fields are required fields of the target, keyed by display name or field ID
+	Fields map[string][]string
+	// Copy allows an issue to be copied to another project on Data Center, where it cannot be moved
+	Copy bool
+	// DeleteOriginal deletes the original after copying it
+	DeleteOriginal bool
+	// ConfirmDelete, if set, is called before copying an issue whose original is to be deleted, and stops
+	// the copy if it returns an error
+	ConfirmDelete func(ctx context.Context, key string) error
+}
+
+// errMoveNeedsCopy is returned when an issue is to be moved to another project on Data Center without allowing a copy
+var errMoveNeedsCopy = errors.New("cannot move an issue to another project, Jira Data Center can only copy it there: " +
+	"the copy gets a new key, and " + notCopied)
+
+// notCopied is what a copy of an issue does not carry over from the original
+const notCopied = "the original's history, worklogs, watchers and votes are not copied"
+
+// moveResult is where an issue ended up
+type moveResult struct {
+	From   string
+	Key    string
+	Status string
+	// Copied is set when the issue was copied rather than moved, and Deleted when the original was then deleted
+	Copied   bool
+	Deleted  bool
+	Warnings []string
+}
+
+// bulkPollInterval is how often to check on a Cloud bulk move; it is a variable so tests can shorten it
+var bulkPollInterval = time.Second
+
+// moveIssue moves an issue to another project or issue type. Jira Cloud moves it in place with the bulk
+// move API. Jira Data Center has no move API, so the issue type is changed by editing the issue, and an issue
+// is moved to another project by copying it there, along with its sub-tasks, links, attachments and comments.
+func moveIssue(ctx context.Context, client *jira.Client, key string, opts moveOptions) (*moveResult, error) {
+	source, _, err := client.Issue.GetWithContext(ctx, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	if opts.Project == "" {
+		opts.Project = source.Fields.Project.Key
+	}
+	if opts.IssueType == "" {
+		opts.IssueType = source.Fields.Type.Name
+	}
+	sameProject := strings.EqualFold(opts.Project, source.Fields.Project.Key)
+	if sameProject && strings.EqualFold(opts.IssueType, source.Fields.Type.Name) {
+		return nil, fmt.Errorf("%s is already a %s in %s", key, source.Fields.Type.Name, source.Fields.Project.Key)
+	}
+	if source.Fields.Type.Subtask && !sameProject && source.Fields.Parent != nil {
+		return nil, fmt.Errorf("%s is a sub-task, which moves with its parent: move %s instead", key, source.Fields.Parent.Key)
+	}
+
+	if isCloud(ctx, client) {
+		return bulkMoveIssue(ctx, client, source, opts)
+	}
+	if sameProject {
+		return changeIssueType(ctx, client, source, opts)
+	}
+	if !opts.Copy {
+		return nil, fmt.Errorf("%w (%s)", errMoveNeedsCopy, key)
+	}
+	return copyMoveIssue(ctx, client, source, opts)
+}
+
+// targetStatus finds the status an issue should have in the target workflow: the one asked for, or the
+// one with the same name as its current status. It returns nil if there is no such status and none was asked for.
+func targetStatus(ctx context.Context, client *jira.Client, source *jira.Issue, opts moveOptions) (*jira.Status, error) {
+	statuses, err := getProjectStatuses(ctx, client, opts.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	name := opts.Status
+	if name == "" {
+		name = source.Fields.Status.Name
+	}
+	for _, issueType := range statuses {
+		if !strings.EqualFold(issueType.Name, opts.IssueType) {
+			continue
+		}
+		var available []string
+		for i, status := range issueType.Statuses {
+			if strings.EqualFold(status.Name, name) {
+				return &issueType.Statuses[i], nil
+			}
+			available = append(available, fmt.Sprintf("%q", status.Name))
+		}
+		if opts.Status != "" {
+			return nil, fmt.Errorf("status %q not found for %s in %s. Available statuses: %s", opts.Status, opts.IssueType, opts.Project, strings.Join(available, ", "))
+		}
+		return nil, nil
+	}
+	return nil, fmt.Errorf("issue type %q not found in project %s", opts.IssueType, opts.Project)
+}
+
+// bulkMoveRequest is the body of POST /rest/api/3/bulk/issues/move
+type bulkMoveRequest struct {
+	SendBulkNotification   bool                       `json:"sendBulkNotification"`
+	TargetToSourcesMapping map[string]bulkMoveMapping `json:"targetToSourcesMapping"`
+}
+
+// bulkMoveMapping moves issues to one project and issue type, letting Jira pick defaults for anything not given
+type bulkMoveMapping struct {
+	InferClassificationDefaults bool                  `json:"inferClassificationDefaults"`
+	InferFieldDefaults          bool                  `json:"inferFieldDefaults"`
+	InferStatusDefaults         bool                  `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault     bool                  `json:"inferSubtaskTypeDefault"`
+	IssueIdsOrKeys              []string              `json:"issueIdsOrKeys"`
+	TargetMandatoryFields       []bulkMandatoryFields `json:"targetMandatoryFields,omitempty"`
+	TargetStatus                []bulkStatusMapping   `json:"targetStatus,omitempty"`
+}
+
+type bulkMandatoryFields struct {
+	Fields map[string]bulkFieldValue `json:"fields"`
+}
+
+type bulkFieldValue struct {
+	Retain bool   `json:"retain"`
+	Type   string `json:"type"`
+	Value  any    `json:"value"`
+}
+
+// bulkStatusMapping maps a target status ID to the source status IDs that become it
+type bulkStatusMapping struct {
+	Statuses map[string][]string `json:"statuses"`
+}
+
+// bulkTask is the progress of a bulk operation
+type bulkTask struct {
+	TaskID                 string              `json:"taskId"`
+	Status                 string              `json:"status"`
+	ProgressPercent        int                 `json:"progressPercent"`
+	FailedAccessibleIssues map[string][]string `json:"failedAccessibleIssues"`
+}
+
+// bulkMoveIssue moves an issue with the Cloud bulk move API and waits for the move to finish
+func bulkMoveIssue(ctx context.Context, client *jira.Client, source *jira.Issue, opts moveOptions) (*moveResult, error) {
+	issueTypes, err := getCreateMetaIssueTypes(ctx, client, opts.Project)
+	if err != nil {
+		return nil, err
+	}
+	var issueTypeID string
+	var names []string
+	for _, issueType := range issueTypes {
+		if strings.EqualFold(issueType.Name, opts.IssueType) {
+			issueTypeID = issueType.ID
+			opts.IssueType = issueType.Name
+		}
+		names = append(names, fmt.Sprintf("%q", issueType.Name))
+	}
+	if issueTypeID == "" {
+		return nil, fmt.Errorf("issue type %q not found in project %s. Available issue types: %s", opts.IssueType, opts.Project, strings.Join(names, ", "))
+	}
+
+	mapping := bulkMoveMapping{
+		InferClassificationDefaults: true,
+		InferFieldDefaults:          true,
+		InferSubtaskTypeDefault:     true,
+		IssueIdsOrKeys:              []string{source.Key},
+	}
+
+	// Keep the status if the target workflow has it, otherwise let Jira use the workflow's default
+	status, err := targetStatus(ctx, client, source, opts)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		mapping.InferStatusDefaults = true
+	} else {
+		mapping.TargetStatus = []bulkStatusMapping{{Statuses: map[string][]string{status.ID: {source.Fields.Status.ID}}}}
+	}
+
+	if len(opts.Fields) > 0 {
+		meta, err := getCreateMeta(ctx, client, opts.Project, opts.IssueType)
+		if err != nil {
+			return nil, err
+		}
+		values, err := resolveFields(ctx, client, meta, opts.Fields, opts.Project, opts.IssueType)
+		if err != nil {
+			return nil, err
+		}
+		fields := map[string]bulkFieldValue{}
+		for id, value := range values {
+			fields[id] = bulkFieldValue{Type: "raw", Value: bulkRawValue(value)}
+		}
+		mapping.TargetMandatoryFields = []bulkMandatoryFields{{Fields: fields}}
+	}
+
+	req, err := client.NewRequestWithContext(ctx, "POST", "rest/api/3/bulk/issues/move", bulkMoveRequest{
+		SendBulkNotification:   true,
+		TargetToSourcesMapping: map[string]bulkMoveMapping{opts.Project + "," + issueTypeID: mapping},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var task bulkTask
+	if _, err := client.Do(req, &task); err != nil {
+		return nil, fmt.Errorf("failed to move issue: %w", err)
+	}
+
+	if err := waitForBulkTask(ctx, client, task.TaskID); err != nil {
+		return nil, err
+	}
+
+	// The old key still finds the issue, under its new key
+	moved, _, err := client.Issue.GetWithContext(ctx, source.Key, &jira.GetQueryOptions{Fields: "status"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moved issue: %w", err)
+	}
+	return &moveResult{From: source.Key, Key: moved.Key, Status: moved.Fields.Status.Name}, nil
+}
+
+// bulkRawValue turns a field value for the create API into the "raw" form the bulk API takes, where
+// options, versions, components and users are given by ID
+func bulkRawValue(value any) any {
+	switch v := value.(type) {
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = bulkRawValue(item)
+		}
+		return items
+	case map[string]any:
+		for _, key := range []string{"id", "accountId", "name", "key"} {
+			if ref, ok := v[key]; ok {
+				return ref
+			}
+		}
+	}
+	return value
+}
+
+// waitForBulkTask polls a bulk operation until it finishes, and reports the reasons it failed
+func waitForBulkTask(ctx context.Context, client *jira.Client, taskID string) error {
+	for {
+		req, err := client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("rest/api/3/bulk/queue/%s", taskID), nil)
+		if err != nil {
+			return err
+		}
+		var task bulkTask
+		if _, err := client.Do(req, &task); err != nil {
+			return fmt.Errorf("failed to get move progress: %w", err)
+		}
+
+		var reasons []string
+		for _, errs := range task.FailedAccessibleIssues {
+			reasons = append(reasons, errs...)
+		}
+		switch task.Status {
+		case "COMPLETE":
+			if len(reasons) > 0 {
+				return fmt.Errorf("failed to move issue: %s", strings.Join(reasons, "; "))
+			}
+			return nil
+		case "FAILED", "CANCELLED", "DEAD":
+			if len(reasons) == 0 {
+				reasons = []string{strings.ToLower(task.Status)}
+			}
+			return fmt.Errorf("failed to move issue: %s", strings.Join(reasons, "; "))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(bulkPollInterval):
+		}
+	}
+}
+
+// changeIssueType changes the issue type of an issue within its project by editing it, which Data Center
+// allows when both issue types share a workflow and field configuration
+func changeIssueType(ctx context.Context, client *jira.Client, source *jira.Issue, opts moveOptions) (*moveResult, error) {
+	fields := map[string]any{"issuetype": map[string]any{"name": opts.IssueType}}
+	if len(opts.Fields) > 0 {
+		meta, err := getCreateMeta(ctx, client, opts.Project, opts.IssueType)
+		if err != nil {
+			return nil, err
+		}
+		values, err := resolveFields(ctx, client, meta, opts.Fields, opts.Project, opts.IssueType)
+		if err != nil {
+			return nil, err
+		}
+		for id, value := range values {
+			fields[id] = value
+		}
+	}
+
+	if _, err := client.Issue.UpdateIssueWithContext(ctx, source.Key, map[string]any{"fields": fields}); err != nil {
+		return nil, fmt.Errorf("failed to change issue type (Jira Data Center can only change it in place when both types share a workflow): %w", err)
+	}
+
+	result := &moveResult{From: source.Key, Key: source.Key, Status: source.Fields.Status.Name}
+	if opts.Status != "" && !strings.EqualFold(opts.Status, result.Status) {
+		if err := transitionTo(ctx, client, source.Key, opts.Status); err != nil {
+			result.Warnings = append(result.Warnings, err.Error())
+		} else {
+			result.Status = opts.Status
+		}
+	}
+	return result, nil
+}
+
+// copyMoveIssue copies an issue to another project on Data Center, where it cannot be moved. The copy gets
+// the original's sub-tasks, links, attachments and comments and, where the workflow allows, its status. The
+// original is deleted if asked, and otherwise gets a comment pointing to the copy.
+func copyMoveIssue(ctx context.Context, client *jira.Client, source *jira.Issue, opts moveOptions) (*moveResult, error) {
+	status, err := targetStatus(ctx, client, source, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DeleteOriginal && opts.ConfirmDelete != nil {
+		if err := opts.ConfirmDelete(ctx, source.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	clone, err := cloneIssue(ctx, client, source.Key, cloneOptions{
+		Project:         opts.Project,
+		IssueType:       opts.IssueType,
+		Fields:          opts.Fields,
+		WithSubtasks:    true,
+		WithLinks:       true,
+		WithAttachments: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &moveResult{From: source.Key, Key: clone.Key, Copied: true, Warnings: clone.Warnings}
+
+	if source.Fields.Comments != nil {
+		for _, comment := range source.Fields.Comments.Comments {
+			body := fmt.Sprintf("_%s wrote on %s:_\n\n%s", comment.Author.DisplayName, comment.Created, comment.Body)
+			if _, _, err := client.Issue.AddCommentWithContext(ctx, clone.Key, &jira.Comment{Body: body}); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("failed to copy comment %s: %v", comment.ID, err))
+			}
+		}
+	}
+
+	if status != nil {
+		if err := transitionTo(ctx, client, clone.Key, status.Name); err != nil {
+			result.Warnings = append(result.Warnings, err.Error())
+		} else {
+			result.Status = status.Name
+		}
+	} else {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("there is no %q status for %s in %s", source.Fields.Status.Name, opts.IssueType, opts.Project))
+	}
+
+	if opts.DeleteOriginal {
+		if err := deleteIssue(ctx, client, source.Key, true); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("failed to delete %s: %v", source.Key, err))
+		} else {
+			result.Deleted = true
+		}
+	} else {
+		body := fmt.Sprintf("Copied to %s", clone.Key)
+		if _, _, err := client.Issue.AddCommentWithContext(ctx, source.Key, &jira.Comment{Body: body}); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("failed to comment on %s: %v", source.Key, err))
+		}
+	}
+
+	return result, nil
+}
+
+// transitionTo moves an issue to a status, if a transition from its current status leads there
+func transitionTo(ctx context.Context, client *jira.Client, key, statusName string) error {
+	issue, _, err := client.Issue.GetWithContext(ctx, key, &jira.GetQueryOptions{Fields: "status"})
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+	if strings.EqualFold(issue.Fields.Status.Name, statusName) {
+		return nil
+	}
+
+	transitions, _, err := client.Issue.GetTransitionsWithContext(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to get transitions: %w", err)
+	}
+	for _, transition := range transitions {
+		if strings.EqualFold(transition.To.Name, statusName) {
+			if _, err := client.Issue.DoTransitionWithContext(ctx, key, transition.ID); err != nil {
+				return fmt.Errorf("failed to move %s to %s: %w", key, statusName, err)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%s was left in %s: there is no transition to %s", key, issue.Fields.Status.Name, statusName)
+}
+
+// formatMoveResult describes a move for the user
+func formatMoveResult(host string, result *moveResult) string {
+	var sb strings.Builder
+	switch {
+	case result.Copied && result.Deleted:
+		fmt.Fprintf(&sb, "Copied %s to the new issue %s (https://%s/browse/%s) and deleted the original\n", result.From, result.Key, host, result.Key)
+	case result.Copied:
+		fmt.Fprintf(&sb, "Copied %s to the new issue %s (https://%s/browse/%s); the original was kept with a comment pointing to the copy\n", result.From, result.Key, host, result.Key)
+	default:
+		fmt.Fprintf(&sb, "Moved %s to %s (https://%s/browse/%s)\n", result.From, result.Key, host, result.Key)
+	}
+	if result.Status != "" {
+		fmt.Fprintf(&sb, "Status: %s\n", result.Status)
+	}
+	if result.Copied {
+		fmt.Fprintf(&sb, "Not carried over: %s, and links to %s from outside Jira still point to the original\n", notCopied, result.From)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(&sb, "Warning: %s\n", warning)
+	}
+	return sb.String()
+}
+
+// confirmOriginalDeletion asks the user to confirm deleting the original of a copy, as delete-issue does
+func confirmOriginalDeletion(ctx context.Context, key string) error {
+	issue, err := checkDeletable(ctx, client, key, true)
+	if err != nil {
+		return err
+	}
+	if !isInteractive() {
+		return fmt.Errorf("not deleting %s without confirmation: use --yes when not running in a terminal", key)
+	}
+	fmt.Fprint(os.Stderr, formatDeletion(issue))
+	ok, err := confirmDeletion(newPrompter(os.Stdin, os.Stderr), key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("confirmation did not match, %s was not copied or deleted", key)
+	}
+	return nil
+}
+
+// moveIssueCommand moves the current issue and prints where it ended up. Deleting the original of a copy
+// is confirmed on a terminal unless yes is set.
+func moveIssueCommand(ctx context.Context, opts moveOptions, yes bool) error {
+	if opts.DeleteOriginal && !yes {
+		opts.ConfirmDelete = confirmOriginalDeletion
+	}
+	result, err := moveIssue(ctx, client, issueKey, opts)
+	if errors.Is(err, errMoveNeedsCopy) {
+		return fmt.Errorf("%w: use --copy to copy it", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatMoveResult(host, result))
+	return nil
+}
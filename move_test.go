@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// moveSourceHandler serves PROJ-1, a Bug in PROJ that is In Progress, which is returned as movedKey once moved is set
+func moveSourceHandler(mux *http.ServeMux, moved *bool, movedKey string) {
+	mux.HandleFunc("GET /rest/api/2/issue/PROJ-1", func(w http.ResponseWriter, r *http.Request) {
+		key, project := "PROJ-1", "PROJ"
+		if *moved {
+			key, project = movedKey, strings.Split(movedKey, "-")[0]
+		}
+		json.NewEncoder(w).Encode(map[string]any{"key": key, "fields": map[string]any{
+			"project":   map[string]any{"key": project},
+			"issuetype": map[string]any{"name": "Bug"},
+			"status":    map[string]any{"id": "1", "name": "In Progress"},
+			"summary":   "Login broken",
+		}})
+	})
+}
+
+func TestMoveIssue_Cloud(t *testing.T) {
+	saved := bulkPollInterval
+	defer func() { bulkPollInterval = saved }()
+	bulkPollInterval = 0
+
+	moved := false
+	mux := http.NewServeMux()
+	moveSourceHandler(mux, &moved, "OTHER-7")
+	mux.HandleFunc("/rest/api/2/issue/createmeta/OTHER/issuetypes", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"values": [{"id": "10", "name": "Bug"}], "total": 1, "isLast": true}`))
+	})
+	mux.HandleFunc("/rest/api/2/project/OTHER/statuses", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name": "Bug", "statuses": [{"id": "3", "name": "In progress"}, {"id": "4", "name": "Done"}]}]`))
+	})
+	var request bulkMoveRequest
+	mux.HandleFunc("/rest/api/3/bulk/issues/move", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&request)
+		w.Write([]byte(`{"taskId": "99"}`))
+	})
+	polls := 0
+	mux.HandleFunc("/rest/api/3/bulk/queue/99", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 2 {
+			w.Write([]byte(`{"taskId": "99", "status": "RUNNING", "progressPercent": 50}`))
+			return
+		}
+		moved = true
+		w.Write([]byte(`{"taskId": "99", "status": "COMPLETE", "progressPercent": 100}`))
+	})
+	c := newTestClient(t, "Cloud", mux)
+
+	result, err := moveIssue(context.Background(), c, "PROJ-1", moveOptions{Project: "OTHER", Fields: map[string][]string{}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Key != "OTHER-7" || result.From != "PROJ-1" || result.Copied {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	mapping, ok := request.TargetToSourcesMapping["OTHER,10"]
+	if !ok {
+		t.Fatalf("Expected a mapping to OTHER,10, got %+v", request.TargetToSourcesMapping)
+	}
+	if !reflect.DeepEqual(mapping.IssueIdsOrKeys, []string{"PROJ-1"}) {
+		t.Errorf("Expected PROJ-1 to be moved, got %v", mapping.IssueIdsOrKeys)
+	}
+	if mapping.InferStatusDefaults || len(mapping.TargetStatus) != 1 || !reflect.DeepEqual(mapping.TargetStatus[0].Statuses, map[string][]string{"3": {"1"}}) {
+		t.Errorf("Expected In Progress to map to status 3, got %+v", mapping)
+	}
+}
+
+func TestMoveIssue_CloudFailure(t *testing.T) {
+	saved := bulkPollInterval
+	defer func() { bulkPollInterval = saved }()
+	bulkPollInterval = 0
+
+	moved := false
+	mux := http.NewServeMux()
+	moveSourceHandler(mux, &moved, "OTHER-7")
+	mux.HandleFunc("/rest/api/2/issue/createmeta/OTHER/issuetypes", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"values": [{"id": "10", "name": "Bug"}], "total": 1, "isLast": true}`))
+	})
+	mux.HandleFunc("/rest/api/2/project/OTHER/statuses", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name": "Bug", "statuses": [{"id": "4", "name": "Done"}]}]`))
+	})
+	mux.HandleFunc("/rest/api/3/bulk/issues/move", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"taskId": "99"}`))
+	})
+	mux.HandleFunc("/rest/api/3/bulk/queue/99", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"taskId": "99", "status": "COMPLETE", "failedAccessibleIssues": {"10001": ["Severity is required."]}}`))
+	})
+	c := newTestClient(t, "Cloud", mux)
+
+	_, err := moveIssue(context.Background(), c, "PROJ-1", moveOptions{Project: "OTHER"})
+	if err == nil || !strings.Contains(err.Error(), "Severity is required.") {
+		t.Errorf("Expected the failure reason, got: %v", err)
+	}
+}
+
+func TestMoveIssue_ChangeType(t *testing.T) {
+	moved := false
+	mux := http.NewServeMux()
+	moveSourceHandler(mux, &moved, "")
+	var body map[string]map[string]map[string]any
+	mux.HandleFunc("PUT /rest/api/2/issue/PROJ-1", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	c := newTestClient(t, "Server", mux)
+
+	result, err := moveIssue(context.Background(), c, "PROJ-1", moveOptions{IssueType: "Story"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Key != "PROJ-1" || result.Status != "In Progress" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if body["fields"]["issuetype"]["name"] != "Story" {
+		t.Errorf("Expected the issue type to be changed to Story, got %v", body)
+	}
+}
+
+func TestMoveIssue_AlreadyThere(t *testing.T) {
+	moved := false
+	mux := http.NewServeMux()
+	moveSourceHandler(mux, &moved, "")
+	c := newTestClient(t, "Server", mux)
+
+	_, err := moveIssue(context.Background(), c, "PROJ-1", moveOptions{Project: "proj", IssueType: "bug"})
+	if err == nil || !strings.Contains(err.Error(), "already a Bug in PROJ") {
+		t.Errorf("Expected an error, got: %v", err)
+	}
+}
+
+func TestMoveIssue_DataCenterNeedsCopy(t *testing.T) {
+	moved := false
+	mux := http.NewServeMux()
+	moveSourceHandler(mux, &moved, "")
+	mux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no issue to be created")
+	})
+	c := newTestClient(t, "Server", mux)
+
+	_, err := moveIssue(context.Background(), c, "PROJ-1", moveOptions{Project: "OTHER"})
+	if !errors.Is(err, errMoveNeedsCopy) {
+		t.Errorf("Expected errMoveNeedsCopy, got: %v", err)
+	}
+}
+
+func TestFormatMoveResult_Copied(t *testing.T) {
+	got := formatMoveResult("jira.example.com", &moveResult{From: "PROJ-1", Key: "OTHER-7", Copied: true})
+	if !strings.Contains(got, "Copied PROJ-1 to the new issue OTHER-7") || !strings.Contains(got, "history, worklogs, watchers and votes are not copied") {
+		t.Errorf("Expected the copy and what was not carried over, got:\n%s", got)
+	}
+}
+
+func TestBulkRawValue(t *testing.T) {
+	value := []any{map[string]any{"id": "10"}, map[string]any{"accountId": "abc"}, "label", 3.0}
+	want := []any{"10", "abc", "label", 3.0}
+	if got := bulkRawValue(value); !reflect.DeepEqual(got, want) {
+		t.Errorf("bulkRawValue() = %v, want %v", got, want)
+	}
+}
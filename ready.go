@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// isBlocksLink reports whether a link type is the "blocks" dependency between issues
+func isBlocksLink(linkType jira.IssueLinkType) bool {
+	return strings.EqualFold(linkType.Name, "Blocks") || strings.EqualFold(linkType.Outward, "blocks")
+}
+
+// plannedIssue is an unresolved issue with the unresolved issues that block it
+type plannedIssue struct {
+	Key       string
+	Summary   string
+	Status    string
+	Assignee  string
+	BlockedBy []string
+}
+
+// readyPlan is the unresolved issues of a query in an order that respects their "blocks" links, the
+// ones ready to start, and the ones that block each other in a cycle and so cannot be ordered
+type readyPlan struct {
+	Order   []*plannedIssue
+	Ready   []*plannedIssue
+	Blocked []*plannedIssue
+	Cycles  [][]string
+}
+
+// planReady orders unresolved issues so that each comes after the issues that block it, keeping the
+// issues' own order where links don't decide it (Kahn's algorithm). An issue is ready when nothing that
+// blocks it, in the issues or outside them, is unresolved.
+func planReady(issues []jira.Issue) *readyPlan {
+	plan := &readyPlan{}
+	planned := map[string]*plannedIssue{}
+	var unresolved []*plannedIssue
+	g := &issueGraph{}
+	for _, issue := range issues {
+		f := issue.Fields
+		if f.Status != nil && f.Status.StatusCategory.Key == "done" {
+			continue
+		}
+		p := &plannedIssue{Key: issue.Key, Summary: f.Summary, Assignee: "Unassigned"}
+		if f.Status != nil {
+			p.Status = f.Status.Name
+		}
+		if f.Assignee != nil {
+			p.Assignee = f.Assignee.DisplayName
+		}
+		for _, link := range f.IssueLinks {
+			blocker := link.InwardIssue
+			if !isBlocksLink(link.Type) || blocker == nil {
+				continue
+			}
+			if blocker.Fields != nil && blocker.Fields.Status != nil && blocker.Fields.Status.StatusCategory.Key == "done" {
+				continue
+			}
+			p.BlockedBy = append(p.BlockedBy, blocker.Key)
+		}
+		planned[p.Key] = p
+		unresolved = append(unresolved, p)
+		g.Nodes = append(g.Nodes, &graphNode{Key: p.Key})
+	}
+
+	inDegree := map[string]int{}
+	blocks := map[string][]string{}
+	for _, p := range unresolved {
+		for _, blocker := range p.BlockedBy {
+			if planned[blocker] != nil {
+				inDegree[p.Key]++
+				blocks[blocker] = append(blocks[blocker], p.Key)
+				g.Edges = append(g.Edges, graphEdge{From: blocker, To: p.Key, Blocks: true})
+			}
+		}
+		if len(p.BlockedBy) == 0 {
+			plan.Ready = append(plan.Ready, p)
+		} else {
+			plan.Blocked = append(plan.Blocked, p)
+		}
+	}
+
+	done := map[string]bool{}
+	for len(plan.Order) < len(unresolved) {
+		var next *plannedIssue
+		for _, p := range unresolved {
+			if !done[p.Key] && inDegree[p.Key] == 0 {
+				next = p
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		done[next.Key] = true
+		plan.Order = append(plan.Order, next)
+		for _, key := range blocks[next.Key] {
+			inDegree[key]--
+		}
+	}
+	plan.Cycles = g.cycles()
+	return plan
+}
+
+// getReadyPlan plans the issues matching JQL, or the issues of an epic given its key
+func getReadyPlan(ctx context.Context, client *jira.Client, query string) (*readyPlan, error) {
+	jql := query
+	if issueKeyPattern.MatchString(query) {
+		var err error
+		if jql, err = epicChildrenJQL(ctx, client, query); err != nil {
+			return nil, err
+		}
+		jql += " ORDER BY rank ASC"
+	}
+
+	issues, err := searchIssues(ctx, client, jql, &jira.SearchOptions{Fields: []string{"summary", "status", "assignee", "issuelinks"}})
+	if err != nil {
+		return nil, err
+	}
+	return planReady(issues), nil
+}
+
+// formatReadyPlan formats the issues ready to start, the blocked issues with what blocks them, the
+// order to work in, and any cycles
+func formatReadyPlan(plan *readyPlan) string {
+	if len(plan.Ready) == 0 && len(plan.Blocked) == 0 {
+		return "No unresolved issues found\n"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Ready to start (%d):\n", len(plan.Ready))
+	for _, p := range plan.Ready {
+		fmt.Fprintf(&sb, "%-15s %-20s %-25s %s\n", p.Key, p.Status, p.Assignee, p.Summary)
+	}
+
+	if len(plan.Blocked) > 0 {
+		fmt.Fprintf(&sb, "\nBlocked (%d):\n", len(plan.Blocked))
+		for _, p := range plan.Blocked {
+			fmt.Fprintf(&sb, "%-15s %-20s %s (blocked by %s)\n", p.Key, p.Status, p.Summary, strings.Join(p.BlockedBy, ", "))
+		}
+	}
+
+	sb.WriteString("\nOrder:\n")
+	for i, p := range plan.Order {
+		fmt.Fprintf(&sb, "%3d. %-15s %s\n", i+1, p.Key, p.Summary)
+	}
+
+	if len(plan.Cycles) > 0 {
+		sb.WriteString("\nCycles (these issues block each other and cannot be ordered):\n")
+		for _, cycle := range plan.Cycles {
+			fmt.Fprintf(&sb, "%s\n", strings.Join(cycle, ", "))
+		}
+	}
+	return sb.String()
+}
+
+// printReadyPlan prints the plan for the issues matching JQL or in an epic
+func printReadyPlan(ctx context.Context, query string) error {
+	plan, err := getReadyPlan(ctx, client, query)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatReadyPlan(plan))
+	return nil
+}
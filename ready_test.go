@@ -0,0 +1,69 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestPlanReady(t *testing.T) {
+	blocks := jira.IssueLinkType{Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}
+	relates := jira.IssueLinkType{Name: "Relates", Inward: "relates to", Outward: "relates to"}
+	status := func(category string) *jira.Status {
+		return &jira.Status{Name: category, StatusCategory: jira.StatusCategory{Key: category}}
+	}
+	blocker := func(key, category string) *jira.IssueLink {
+		return &jira.IssueLink{Type: blocks, InwardIssue: &jira.Issue{Key: key, Fields: &jira.IssueFields{Status: status(category)}}}
+	}
+	issue := func(key, category string, links ...*jira.IssueLink) jira.Issue {
+		i := testIssue(key, "Story", "Summary of "+key)
+		i.Fields.Status = status(category)
+		i.Fields.IssueLinks = links
+		return i
+	}
+
+	plan := planReady([]jira.Issue{
+		issue("PROJ-3", "new", blocker("PROJ-2", "new")),
+		issue("PROJ-2", "new", blocker("PROJ-1", "indeterminate")),
+		issue("PROJ-1", "indeterminate", blocker("PROJ-0", "done")),
+		issue("PROJ-0", "done"),
+		issue("PROJ-4", "new", &jira.IssueLink{Type: relates, InwardIssue: &jira.Issue{Key: "PROJ-1"}}),
+		issue("PROJ-5", "new", blocker("OTHER-1", "new")),
+		issue("PROJ-6", "new", blocker("PROJ-7", "new")),
+		issue("PROJ-7", "new", blocker("PROJ-6", "new")),
+	})
+
+	keys := func(issues []*plannedIssue) []string {
+		var keys []string
+		for _, p := range issues {
+			keys = append(keys, p.Key)
+		}
+		return keys
+	}
+	if got, want := keys(plan.Ready), []string{"PROJ-1", "PROJ-4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected ready %v, got %v", want, got)
+	}
+	if got, want := keys(plan.Blocked), []string{"PROJ-3", "PROJ-2", "PROJ-5", "PROJ-6", "PROJ-7"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected blocked %v, got %v", want, got)
+	}
+	if got, want := keys(plan.Order), []string{"PROJ-1", "PROJ-2", "PROJ-3", "PROJ-4", "PROJ-5"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected order %v, got %v", want, got)
+	}
+	if got, want := plan.Cycles, [][]string{{"PROJ-6", "PROJ-7"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected cycles %v, got %v", want, got)
+	}
+
+	got := formatReadyPlan(plan)
+	for _, want := range []string{
+		"Ready to start (2):",
+		"PROJ-5          new                  Summary of PROJ-5 (blocked by OTHER-1)",
+		"  1. PROJ-1          Summary of PROJ-1",
+		"PROJ-6, PROJ-7",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// searchIssues returns every issue matching the JQL, fetching as many pages as it takes
+func searchIssues(ctx context.Context, client *jira.Client, jql string, options *jira.SearchOptions) ([]jira.Issue, error) {
+	opts := jira.SearchOptions{MaxResults: 100}
+	if options != nil {
+		opts = *options
+		if opts.MaxResults == 0 {
+			opts.MaxResults = 100
+		}
+	}
+
+	var all []jira.Issue
+	for {
+		opts.StartAt = len(all)
+		issues, resp, err := client.Issue.SearchWithContext(ctx, jql, &opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search issues: %w", err)
+		}
+		all = append(all, issues...)
+		if len(issues) == 0 || resp == nil || len(all) >= resp.Total {
+			return all, nil
+		}
+	}
+}
+
+// quoteJQL quotes a value for use in JQL, such as a version or status name that may contain spaces
+func quoteJQL(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// orderByPattern matches the ORDER BY keywords of JQL
+var orderByPattern = regexp.MustCompile(`(?i)\border\s+by\b`)
+
+// stripOrderBy removes the ORDER BY clause from the end of JQL, so that it can be combined with more
+// conditions. "order by" inside a quoted value is left alone.
+func stripOrderBy(jql string) string {
+	for _, loc := range orderByPattern.FindAllStringIndex(jql, -1) {
+		var quote rune
+		escaped := false
+		for _, r := range jql[:loc[0]] {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case quote != 0:
+				if r == quote {
+					quote = 0
+				}
+			case r == '"' || r == '\'':
+				quote = r
+			}
+		}
+		if quote == 0 {
+			return strings.TrimSpace(jql[:loc[0]])
+		}
+	}
+	return strings.TrimSpace(jql)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestSearchIssues(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		var issues []map[string]any
+		for i := startAt; i < startAt+2 && i < 5; i++ {
+			issues = append(issues, map[string]any{"key": fmt.Sprintf("PROJ-%d", i+1)})
+		}
+		json.NewEncoder(w).Encode(map[string]any{"startAt": startAt, "maxResults": 2, "total": 5, "issues": issues})
+	})
+	c := newTestClient(t, "Server", mux)
+
+	issues, err := searchIssues(context.Background(), c, "project = PROJ", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(issues) != 5 || issues[4].Key != "PROJ-5" {
+		t.Errorf("Expected all 5 issues across pages, got %d", len(issues))
+	}
+}
+
+func TestQuoteJQL(t *testing.T) {
+	if got, want := quoteJQL(`Release "2" \ final`), `"Release \"2\" \\ final"`; got != want {
+		t.Errorf("quoteJQL() = %s, want %s", got, want)
+	}
+}
+
+func TestStripOrderBy(t *testing.T) {
+	tests := []struct {
+		jql  string
+		want string
+	}{
+		{"project = PROJ", "project = PROJ"},
+		{"project = PROJ ORDER BY created DESC", "project = PROJ"},
+		{"project = PROJ order  by rank", "project = PROJ"},
+		{`summary ~ "sort order by date" ORDER BY key`, `summary ~ "sort order by date"`},
+		{`summary ~ "say \"hi\" order by" order by key`, `summary ~ "say \"hi\" order by"`},
+		{"ORDER BY key", ""},
+	}
+	for _, tt := range tests {
+		if got := stripOrderBy(tt.jql); got != tt.want {
+			t.Errorf("stripOrderBy(%q) = %q, want %q", tt.jql, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// resolveBoard finds a board by ID, or by name ignoring case
+func resolveBoard(ctx context.Context, client *jira.Client, board string) (*jira.Board, error) {
+	if id, err := strconv.Atoi(board); err == nil {
+		b, _, err := client.Board.GetBoardWithContext(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get board: %w", err)
+		}
+		return b, nil
+	}
+
+	boards, _, err := client.Board.GetAllBoardsWithContext(ctx, &jira.BoardListOptions{Name: board})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get boards: %w", err)
+	}
+	var names []string
+	for i, b := range boards.Values {
+		if strings.EqualFold(b.Name, board) {
+			return &boards.Values[i], nil
+		}
+		names = append(names, fmt.Sprintf("%q (%d)", b.Name, b.ID))
+	}
+	if len(boards.Values) == 1 {
+		return &boards.Values[0], nil
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no board found matching %q", board)
+	}
+	return nil, fmt.Errorf("%q matches more than one board, use its name or ID: %s", board, strings.Join(names, ", "))
+}
+
+// getSprint returns a sprint by ID
+func getSprint(ctx context.Context, client *jira.Client, id int) (*jira.Sprint, error) {
+	req, err := client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("rest/agile/1.0/sprint/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	var sprint jira.Sprint
+	if _, err := client.Do(req, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to get sprint: %w", err)
+	}
+	return &sprint, nil
+}
+
+// boardSprints returns the sprints of a board in the given states (comma-separated, all if empty), oldest first
+func boardSprints(ctx context.Context, client *jira.Client, boardID int, state string) ([]jira.Sprint, error) {
+	var all []jira.Sprint
+	for {
+		sprints, _, err := client.Board.GetAllSprintsWithOptionsWithContext(ctx, boardID, &jira.GetAllSprintsOptions{
+			State:         state,
+			SearchOptions: jira.SearchOptions{StartAt: len(all)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sprints: %w", err)
+		}
+		all = append(all, sprints.Values...)
+		if sprints.IsLast || len(sprints.Values) == 0 {
+			return all, nil
+		}
+	}
+}
+
+// findSprint finds a sprint by ID, or by name on a board; with no sprint given, it is the board's active sprint
+func findSprint(ctx context.Context, client *jira.Client, board, sprint string) (*jira.Sprint, error) {
+	if id, err := strconv.Atoi(sprint); err == nil {
+		return getSprint(ctx, client, id)
+	}
+	if board == "" {
+		return nil, fmt.Errorf("a board is needed to find a sprint by name or the active sprint, use --board")
+	}
+	b, err := resolveBoard(ctx, client, board)
+	if err != nil {
+		return nil, err
+	}
+
+	state := ""
+	if sprint == "" {
+		state = "active"
+	}
+	sprints, err := boardSprints(ctx, client, b.ID, state)
+	if err != nil {
+		return nil, err
+	}
+	if sprint == "" {
+		if len(sprints) == 0 {
+			return nil, fmt.Errorf("no active sprint found for board %s", b.Name)
+		}
+		return &sprints[0], nil
+	}
+	for i, s := range sprints {
+		if strings.EqualFold(s.Name, sprint) {
+			return &sprints[i], nil
+		}
+	}
+	return nil, fmt.Errorf("sprint %q not found on board %s", sprint, b.Name)
+}
+
+// sprintIDs parses the sprint IDs of a Sprint field change, e.g. "12, 15"
+func sprintIDs(value any) []int {
+	s, _ := value.(string)
+	var ids []int
+	for _, part := range strings.Split(s, ",") {
+		if id, err := strconv.Atoi(strings.TrimSpace(part)); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// sprintAddedAt returns when an issue was last added to a sprint, from its changelog, or when it was
+// created if it was created in the sprint
+func sprintAddedAt(issue jira.Issue, sprintID int) time.Time {
+	contains := func(ids []int) bool {
+		for _, id := range ids {
+			if id == sprintID {
+				return true
+			}
+		}
+		return false
+	}
+
+	added := time.Time(issue.Fields.Created)
+	if issue.Changelog == nil {
+		return added
+	}
+	for _, history := range issue.Changelog.Histories {
+		for _, item := range history.Items {
+			if !strings.EqualFold(item.Field, "Sprint") || !contains(sprintIDs(item.To)) || contains(sprintIDs(item.From)) {
+				continue
+			}
+			if at, err := time.Parse(jiraTimeLayout, history.Created); err == nil && at.After(added) {
+				added = at
+			}
+		}
+	}
+	return added
+}
+
+// sprintReport is the issues of a sprint: those in it when it started, those added after, those done by
+// its end (or now, if it is active) and those not
+type sprintReport struct {
+	Sprint        jira.Sprint
+	Committed     []jira.Issue
+	Added         []jira.Issue
+	Completed     []jira.Issue
+	NotCompleted  []jira.Issue
+	PointsFieldID string
+}
+
+// points returns the story points of issues
+func (r *sprintReport) points(issues []jira.Issue) float64 {
+	var total float64
+	for _, issue := range issues {
+		points, _ := storyPoints(issue, r.PointsFieldID)
+		total += points
+	}
+	return total
+}
+
+// getSprintReport works out the report of a sprint that has started
+func getSprintReport(ctx context.Context, client *jira.Client, sprint jira.Sprint) (*sprintReport, error) {
+	if sprint.StartDate == nil {
+		return nil, fmt.Errorf("sprint %s has not started", sprint.Name)
+	}
+	end := time.Now()
+	if sprint.CompleteDate != nil {
+		end = *sprint.CompleteDate
+	}
+
+	r := &sprintReport{Sprint: sprint, PointsFieldID: storyPointsFieldID(ctx, client)}
+	fields := []string{"summary", "status", "created", "resolutiondate"}
+	if r.PointsFieldID != "" {
+		fields = append(fields, r.PointsFieldID)
+	}
+	issues, err := searchIssues(ctx, client, fmt.Sprintf("sprint = %d ORDER BY key ASC", sprint.ID), &jira.SearchOptions{Fields: fields, Expand: "changelog"})
+	if err != nil {
+		return nil, err
+	}
+	if err := completeChangelogs(ctx, client, issues); err != nil {
+		return nil, err
+	}
+
+	for _, issue := range issues {
+		if sprintAddedAt(issue, sprint.ID).After(*sprint.StartDate) {
+			r.Added = append(r.Added, issue)
+		} else {
+			r.Committed = append(r.Committed, issue)
+		}
+		resolved := time.Time(issue.Fields.Resolutiondate)
+		done := issue.Fields.Status != nil && issue.Fields.Status.StatusCategory.Key == "done"
+		if done && (resolved.IsZero() || !resolved.After(end)) {
+			r.Completed = append(r.Completed, issue)
+		} else {
+			r.NotCompleted = append(r.NotCompleted, issue)
+		}
+	}
+	return r, nil
+}
+
+// formatSprintReport formats the counts and story points of a sprint's issues, and lists them
+func formatSprintReport(r *sprintReport) string {
+	s := r.Sprint
+	notCompleted := "Carried over"
+	if s.State == "active" {
+		notCompleted = "Remaining"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s (%s)", s.Name, s.State)
+	if s.StartDate != nil && s.EndDate != nil {
+		fmt.Fprintf(&sb, " %s to %s", s.StartDate.Format(time.DateOnly), s.EndDate.Format(time.DateOnly))
+	}
+	sb.WriteString("\n\n")
+
+	rows := []struct {
+		name   string
+		issues []jira.Issue
+	}{
+		{"Committed", r.Committed},
+		{"Added", r.Added},
+		{"Completed", r.Completed},
+		{notCompleted, r.NotCompleted},
+	}
+	fmt.Fprintf(&sb, "%-14s %-7s %s\n", "", "Issues", "Points")
+	for _, row := range rows {
+		fmt.Fprintf(&sb, "%-14s %-7d %g\n", row.name, len(row.issues), r.points(row.issues))
+	}
+
+	for _, row := range rows[1:] {
+		if len(row.issues) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n%s:\n", row.name)
+		for _, issue := range row.issues {
+			points := ""
+			if v, ok := storyPoints(issue, r.PointsFieldID); ok {
+				points = fmt.Sprintf("%g", v)
+			}
+			fmt.Fprintf(&sb, "%-15s %-20s %-5s %s\n", issue.Key, issue.Fields.Status.Name, points, issue.Fields.Summary)
+		}
+	}
+	return sb.String()
+}
+
+// formatVelocity formats the committed and completed work of each sprint, and the average across them
+func formatVelocity(reports []*sprintReport) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-25s %-10s %-10s %-17s %s\n", "Sprint", "Committed", "Completed", "Committed points", "Completed points")
+	var committed, completed int
+	var committedPoints, completedPoints float64
+	for _, r := range reports {
+		cp, dp := r.points(r.Committed), r.points(r.Completed)
+		fmt.Fprintf(&sb, "%-25s %-10d %-10d %-17g %g\n", r.Sprint.Name, len(r.Committed), len(r.Completed), cp, dp)
+		committed += len(r.Committed)
+		completed += len(r.Completed)
+		committedPoints += cp
+		completedPoints += dp
+	}
+	if n := float64(len(reports)); n > 0 {
+		fmt.Fprintf(&sb, "%-25s %-10.1f %-10.1f %-17.1f %.1f\n", fmt.Sprintf("Average (%d sprints)", len(reports)),
+			float64(committed)/n, float64(completed)/n, committedPoints/n, completedPoints/n)
+	}
+	return sb.String()
+}
+
+// printSprintReport prints the report of a sprint, by default the board's active sprint
+func printSprintReport(ctx context.Context, board, sprint string) error {
+	s, err := findSprint(ctx, client, board, sprint)
+	if err != nil {
+		return err
+	}
+	r, err := getSprintReport(ctx, client, *s)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatSprintReport(r))
+	return nil
+}
+
+// printVelocity prints the committed and completed work of the last closed sprints of a board
+func printVelocity(ctx context.Context, board string, last int) error {
+	b, err := resolveBoard(ctx, client, board)
+	if err != nil {
+		return err
+	}
+	sprints, err := boardSprints(ctx, client, b.ID, "closed")
+	if err != nil {
+		return err
+	}
+	if len(sprints) == 0 {
+		return fmt.Errorf("no closed sprints found for board %s", b.Name)
+	}
+	if len(sprints) > last {
+		sprints = sprints[len(sprints)-last:]
+	}
+
+	var reports []*sprintReport
+	for _, sprint := range sprints {
+		r, err := getSprintReport(ctx, client, sprint)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, r)
+	}
+
+	fmt.Print(formatVelocity(reports))
+	return nil
+}
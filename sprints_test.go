@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestGetSprintReport(t *testing.T) {
+	issue := func(key, category, resolved string, points float64, sprintAdded string) map[string]any {
+		fields := map[string]any{
+			"summary":           "Summary of " + key,
+			"status":            map[string]any{"name": category, "statusCategory": map[string]any{"key": category}},
+			"created":           "2026-09-20T10:00:00.000+0000",
+			"customfield_10002": points,
+		}
+		if resolved != "" {
+			fields["resolutiondate"] = resolved
+		}
+		histories := []any{}
+		if sprintAdded != "" {
+			histories = append(histories, map[string]any{"created": sprintAdded, "items": []any{
+				map[string]any{"field": "Sprint", "from": "40", "to": "40, 41"},
+			}})
+		}
+		return map[string]any{"key": key, "fields": fields, "changelog": map[string]any{"histories": histories}}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/field", epicFieldsHandler)
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("jql"); got != "sprint = 41 ORDER BY key ASC" {
+			t.Errorf("Unexpected JQL %q", got)
+		}
+		issues := []any{
+			issue("PROJ-1", "done", "2026-10-01T10:00:00.000+0000", 3, "2026-09-27T10:00:00.000+0000"),
+			issue("PROJ-2", "done", "2026-10-12T10:00:00.000+0000", 5, ""),
+			issue("PROJ-3", "indeterminate", "", 2, ""),
+			issue("PROJ-4", "done", "2026-10-02T10:00:00.000+0000", 1, "2026-09-30T10:00:00.000+0000"),
+		}
+		json.NewEncoder(w).Encode(map[string]any{"total": len(issues), "issues": issues})
+	})
+	c := newTestClient(t, "Cloud", mux)
+
+	start := time.Date(2026, 9, 28, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 9, 17, 0, 0, 0, time.UTC)
+	sprint := jira.Sprint{ID: 41, Name: "Sprint 41", State: "closed", StartDate: &start, EndDate: &end, CompleteDate: &end}
+
+	r, err := getSprintReport(context.Background(), c, sprint)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := `Sprint 41 (closed) 2026-09-28 to 2026-10-09
+
+               Issues  Points
+Committed      3       10
+Added          1       1
+Completed      2       4
+Carried over   2       7
+`
+	if got := formatSprintReport(r); !strings.HasPrefix(got, want) {
+		t.Errorf("Expected report to start with:\n%s\ngot:\n%s", want, got)
+	}
+
+	velocity := formatVelocity([]*sprintReport{r, r})
+	if !strings.Contains(velocity, "Average (2 sprints)       3.0        2.0        10.0              4.0") {
+		t.Errorf("Unexpected velocity:\n%s", velocity)
+	}
+}
+
+func TestSprintIDs(t *testing.T) {
+	got := sprintIDs("12, 15,x")
+	if len(got) != 2 || got[0] != 12 || got[1] != 15 {
+		t.Errorf("Expected [12 15], got %v", got)
+	}
+	if got := sprintIDs(nil); len(got) != 0 {
+		t.Errorf("Expected no IDs, got %v", got)
+	}
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// parseSince parses when a standup covers work since: "yesterday" (the previous working day), "today",
+// a duration such as "24h" or "3d", or a date such as "2026-10-15"
+func parseSince(s string, now time.Time) (time.Time, error) {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch strings.ToLower(s) {
+	case "today":
+		return midnight, nil
+	case "yesterday":
+		day := midnight.AddDate(0, 0, -1)
+		for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			day = day.AddDate(0, 0, -1)
+		}
+		return day, nil
+	}
+	if n, err := parseDays(s); err == nil {
+		return midnight.AddDate(0, 0, -n), nil
+	}
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, now.Location()); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: expected yesterday, today, a duration such as 24h or 3d, or a date such as 2026-10-15", s)
+}
+
+// standupIssue is an issue the user worked on, with what they did
+type standupIssue struct {
+	Key        string
+	Summary    string
+	Status     string
+	Activities []string
+}
+
+// standupReport is the issues a user worked on since a time, grouped by where they are now
+type standupReport struct {
+	Since      time.Time
+	Done       []standupIssue
+	InProgress []standupIssue
+	Blocked    []standupIssue
+	ToDo       []standupIssue
+}
+
+// standupActivities describes what the user did to an issue since a time: created it, changed its status,
+// commented on it or logged work on it
+func standupActivities(issue jira.Issue, me *jira.User, since time.Time) []string {
+	var activities []string
+	f := issue.Fields
+	if sameUser(f.Reporter, me) && !time.Time(f.Created).Before(since) {
+		activities = append(activities, "created")
+	}
+
+	if issue.Changelog != nil {
+		for _, history := range issue.Changelog.Histories {
+			at, err := time.Parse(jiraTimeLayout, history.Created)
+			if err != nil || at.Before(since) || !sameUser(&history.Author, me) {
+				continue
+			}
+			for _, item := range history.Items {
+				if item.Field == "status" {
+					activities = append(activities, fmt.Sprintf("moved %s -> %s", item.FromString, item.ToString))
+				}
+			}
+		}
+	}
+
+	comments := 0
+	if f.Comments != nil {
+		for _, comment := range f.Comments.Comments {
+			at, err := time.Parse(jiraTimeLayout, comment.Created)
+			if err == nil && !at.Before(since) && sameUser(&comment.Author, me) {
+				comments++
+			}
+		}
+	}
+	if comments == 1 {
+		activities = append(activities, "commented")
+	} else if comments > 1 {
+		activities = append(activities, fmt.Sprintf("commented %d times", comments))
+	}
+
+	if f.Worklog != nil {
+		var seconds int
+		for _, worklog := range f.Worklog.Worklogs {
+			if worklog.Started != nil && !time.Time(*worklog.Started).Before(since) && sameUser(worklog.Author, me) {
+				seconds += worklog.TimeSpentSeconds
+			}
+		}
+		if seconds > 0 {
+			activities = append(activities, fmt.Sprintf("logged %s", (time.Duration(seconds)*time.Second).String()))
+		}
+	}
+	return activities
+}
+
+// isBlockedIssue reports whether an issue is blocked: its status says so, or an unresolved issue blocks it
+func isBlockedIssue(issue jira.Issue) bool {
+	f := issue.Fields
+	if f.Status != nil && strings.Contains(strings.ToLower(f.Status.Name), "block") {
+		return true
+	}
+	for _, link := range f.IssueLinks {
+		blocker := link.InwardIssue
+		if isBlocksLink(link.Type) && blocker != nil && blocker.Fields != nil && blocker.Fields.Status != nil &&
+			blocker.Fields.Status.StatusCategory.Key != "done" {
+			return true
+		}
+	}
+	return false
+}
+
+// issueWorklogs returns the worklogs of an issue started since a time, a page at a time. Cloud filters by
+// startedAfter; Server/Data Center returns all of them, which standupActivities filters.
+func issueWorklogs(ctx context.Context, client *jira.Client, key string, since time.Time) ([]jira.WorklogRecord, error) {
+	var all []jira.WorklogRecord
+	for {
+		worklog, _, err := client.Issue.GetWorklogsWithContext(ctx, key, jira.WithQueryOptions(&struct {
+			StartAt      int   `url:"startAt"`
+			MaxResults   int   `url:"maxResults"`
+			StartedAfter int64 `url:"startedAfter"`
+		}{len(all), 1000, since.UnixMilli()}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get worklogs of %s: %w", key, err)
+		}
+		all = append(all, worklog.Worklogs...)
+		if len(worklog.Worklogs) == 0 || len(all) >= worklog.Total {
+			return all, nil
+		}
+	}
+}
+
+// getStandup finds the issues the current user created, moved, commented on or logged work on since a time
+func getStandup(ctx context.Context, client *jira.Client, since time.Time) (*standupReport, error) {
+	me, _, err := client.User.GetSelfWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	// Commenting on an issue makes you a watcher of it, so the watcher clause finds the issues commented on
+	when := quoteJQL(since.Format("2006/01/02 15:04"))
+	jql := fmt.Sprintf("updated >= %s AND (assignee = currentUser() OR reporter = currentUser() OR watcher = currentUser() OR worklogAuthor = currentUser() OR status CHANGED BY currentUser() AFTER %s) ORDER BY updated DESC", when, when)
+	issues, err := searchIssues(ctx, client, jql, &jira.SearchOptions{
+		Fields: []string{"summary", "status", "reporter", "created", "comment", "worklog", "issuelinks"},
+		Expand: "changelog",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := completeChangelogs(ctx, client, issues); err != nil {
+		return nil, err
+	}
+
+	r := &standupReport{Since: since}
+	for _, issue := range issues {
+		// Search results have only the first 20 worklogs of an issue, oldest first
+		if w := issue.Fields.Worklog; w != nil && w.Total > len(w.Worklogs) {
+			if w.Worklogs, err = issueWorklogs(ctx, client, issue.Key, since); err != nil {
+				return nil, err
+			}
+		}
+		activities := standupActivities(issue, me, since)
+		if len(activities) == 0 {
+			continue
+		}
+		s := standupIssue{Key: issue.Key, Summary: issue.Fields.Summary, Activities: activities}
+		category := ""
+		if issue.Fields.Status != nil {
+			s.Status = issue.Fields.Status.Name
+			category = issue.Fields.Status.StatusCategory.Key
+		}
+		switch {
+		case category == "done":
+			r.Done = append(r.Done, s)
+		case isBlockedIssue(issue):
+			r.Blocked = append(r.Blocked, s)
+		case category == "indeterminate":
+			r.InProgress = append(r.InProgress, s)
+		default:
+			r.ToDo = append(r.ToDo, s)
+		}
+	}
+	return r, nil
+}
+
+// formatStandup formats the issues grouped as done, in progress, blocked and to do, with what was done to each
+func formatStandup(r *standupReport) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Since %s:\n", r.Since.Format("Mon 2006-01-02 15:04"))
+	empty := true
+	for _, group := range []struct {
+		name   string
+		issues []standupIssue
+	}{
+		{"Done", r.Done},
+		{"In progress", r.InProgress},
+		{"Blocked", r.Blocked},
+		{"To do", r.ToDo},
+	} {
+		if len(group.issues) == 0 {
+			continue
+		}
+		empty = false
+		fmt.Fprintf(&sb, "\n%s:\n", group.name)
+		for _, issue := range group.issues {
+			fmt.Fprintf(&sb, "- %s %s (%s): %s\n", issue.Key, issue.Summary, issue.Status, strings.Join(issue.Activities, ", "))
+		}
+	}
+	if empty {
+		sb.WriteString("\nNo activity found\n")
+	}
+	return sb.String()
+}
+
+// printStandup prints what the current user did since a time
+func printStandup(ctx context.Context, since time.Time) error {
+	r, err := getStandup(ctx, client, since)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatStandup(r))
+	return nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	// Monday 2026-10-19 09:30
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	tests := []struct {
+		since string
+		want  time.Time
+	}{
+		{"yesterday", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"today", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"24h", time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)},
+		{"3d", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"2026-10-12", time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseSince(tt.since, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseSince(%q) = %v, %v, want %v", tt.since, got, err, tt.want)
+		}
+	}
+
+	// Tuesday's yesterday is Monday
+	if got, _ := parseSince("yesterday", now.AddDate(0, 0, 1)); !got.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Monday, got %v", got)
+	}
+	if _, err := parseSince("last week", now); err == nil {
+		t.Error("Expected an error for an invalid time")
+	}
+}
+
+func TestGetStandup(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "jdoe", "displayName": "John Doe"}`))
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		jql := r.URL.Query().Get("jql")
+		if !strings.Contains(jql, `updated >= "2026/10/16 00:00"`) || !strings.Contains(jql, "worklogAuthor = currentUser()") {
+			t.Errorf("Unexpected JQL: %s", jql)
+		}
+		w.Write([]byte(`{"total": 5, "issues": [
+			{"key": "PROJ-1", "fields": {"summary": "Add search endpoint", "status": {"name": "Done", "statusCategory": {"key": "done"}},
+				"reporter": {"name": "asmith"}, "created": "2026-10-01T09:00:00.000+0000"},
+				"changelog": {"histories": [
+					{"author": {"name": "jdoe"}, "created": "2026-10-16T15:00:00.000+0000", "items": [
+						{"field": "status", "fromString": "In Review", "toString": "Done"}
+					]}
+				]}},
+			{"key": "PROJ-2", "fields": {"summary": "Paginate search results", "status": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}},
+				"reporter": {"name": "jdoe"}, "created": "2026-10-16T10:00:00.000+0000",
+				"comment": {"comments": [{"author": {"name": "asmith"}, "created": "2026-10-16T11:00:00.000+0000"}]},
+				"worklog": {"worklogs": [{"author": {"name": "jdoe"}, "started": "2026-10-16T12:00:00.000+0000", "timeSpentSeconds": 9000}]}}},
+			{"key": "PROJ-3", "fields": {"summary": "Index documents", "status": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}},
+				"reporter": {"name": "asmith"}, "created": "2026-10-01T09:00:00.000+0000",
+				"comment": {"comments": [
+					{"author": {"name": "jdoe"}, "created": "2026-10-16T11:00:00.000+0000"},
+					{"author": {"name": "jdoe"}, "created": "2026-10-17T11:00:00.000+0000"}
+				]},
+				"issuelinks": [{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+					"inwardIssue": {"key": "PROJ-9", "fields": {"status": {"name": "To Do", "statusCategory": {"key": "new"}}}}}]}},
+			{"key": "PROJ-4", "fields": {"summary": "Moved by someone else", "status": {"name": "Done", "statusCategory": {"key": "done"}},
+				"reporter": {"name": "asmith"}, "created": "2026-10-01T09:00:00.000+0000"},
+				"changelog": {"histories": [
+					{"author": {"name": "asmith"}, "created": "2026-10-16T15:00:00.000+0000", "items": [
+						{"field": "status", "fromString": "In Review", "toString": "Done"}
+					]}
+				]}},
+			{"key": "PROJ-5", "fields": {"summary": "Commented on last week", "status": {"name": "To Do", "statusCategory": {"key": "new"}},
+				"reporter": {"name": "asmith"}, "created": "2026-10-01T09:00:00.000+0000",
+				"comment": {"comments": [{"author": {"name": "jdoe"}, "created": "2026-10-10T11:00:00.000+0000"}]}}}
+		]}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	r, err := getStandup(context.Background(), c, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := `Since Fri 2026-10-16 00:00:
+
+Done:
+- PROJ-1 Add search endpoint (Done): moved In Review -> Done
+
+In progress:
+- PROJ-2 Paginate search results (In Progress): created, logged 2h30m0s
+
+Blocked:
+- PROJ-3 Index documents (In Progress): commented 2 times
+`
+	if got := formatStandup(r); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestGetStandup_TruncatedWorklogs(t *testing.T) {
+	since := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "jdoe", "displayName": "John Doe"}`))
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"total": 1, "issues": [
+			{"key": "PROJ-1", "fields": {"summary": "Busy issue", "status": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}},
+				"reporter": {"name": "asmith"}, "created": "2026-01-01T09:00:00.000+0000",
+				"worklog": {"startAt": 0, "maxResults": 20, "total": 21, "worklogs": [
+					{"author": {"name": "jdoe"}, "started": "2026-01-02T12:00:00.000+0000", "timeSpentSeconds": 3600}
+				]}}}
+		]}`))
+	})
+	mux.HandleFunc("/rest/api/2/issue/PROJ-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("startedAfter"), fmt.Sprint(since.UnixMilli()); got != want {
+			t.Errorf("Expected startedAfter=%s, got %q", want, got)
+		}
+		w.Write([]byte(`{"startAt": 0, "maxResults": 1000, "total": 1, "worklogs": [
+			{"author": {"name": "jdoe"}, "started": "2026-10-16T12:00:00.000+0000", "timeSpentSeconds": 1800}
+		]}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	r, err := getStandup(context.Background(), c, since)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(r.InProgress) != 1 || strings.Join(r.InProgress[0].Activities, ", ") != "logged 30m0s" {
+		t.Errorf("Expected the recent worklog to be found, got %+v", r)
+	}
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/kitproj/jira-cli/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplate fills in new issues of a project and issue type. An empty project or type matches any.
+type issueTemplate struct {
+	Project     string         `yaml:"project"`
+	IssueType   string         `yaml:"type"`
+	Description string         `yaml:"description"`
+	Labels      []string       `yaml:"labels"`
+	Fields      map[string]any `yaml:"fields"`
+}
+
+// loadTemplates reads templates.yaml from the config directory; it is a variable so tests can replace it
+var loadTemplates = func() ([]issueTemplate, error) {
+	path, err := config.Path("templates.yaml")
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+
+	var file struct {
+		Templates []issueTemplate `yaml:"templates"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return file.Templates, nil
+}
+
+// findTemplate returns the most specific template for the project and issue type: one naming both wins
+// over one naming only the issue type, which wins over one naming only the project
+func findTemplate(templates []issueTemplate, project, issueType string) *issueTemplate {
+	var best *issueTemplate
+	bestScore := -1
+	for i, t := range templates {
+		if (t.Project != "" && !strings.EqualFold(t.Project, project)) || (t.IssueType != "" && !strings.EqualFold(t.IssueType, issueType)) {
+			continue
+		}
+		score := 0
+		if t.IssueType != "" {
+			score += 2
+		}
+		if t.Project != "" {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = &templates[i], score
+		}
+	}
+	return best
+}
+
+// templateData is what templates can refer to, e.g. {{.Branch}}, {{.User}} or {{.Date}}
+type templateData struct {
+	ctx    context.Context
+	client *jira.Client
+	user   string
+
+	Project     string
+	IssueType   string
+	Summary     string
+	Description string
+	// Date is today's date, as YYYY-MM-DD
+	Date string
+}
+
+// Branch returns the current git branch, or nothing outside a git repository
+func (d *templateData) Branch() string {
+	out, err := exec.CommandContext(d.ctx, "git", "branch", "--show-current").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// User returns the display name of the current user, looked up the first time it is used
+func (d *templateData) User() (string, error) {
+	if d.user == "" {
+		self, _, err := d.client.User.GetSelfWithContext(d.ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to get current user: %w", err)
+		}
+		d.user = self.DisplayName
+	}
+	return d.user, nil
+}
+
+// render expands a template against the data
+func (d *templateData) render(name, text string) (string, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid template for %s: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, d); err != nil {
+		return "", fmt.Errorf("failed to expand template for %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// applyTemplate fills in the request from the template for its project and issue type. The template's
+// description is used when the request has none; otherwise the request's description goes first, unless
+// the template places it with {{.Description}}. Labels are added, and fields are set unless already given.
+func applyTemplate(ctx context.Context, client *jira.Client, req *issueRequest) error {
+	templates, err := loadTemplates()
+	if err != nil {
+		return err
+	}
+	t := findTemplate(templates, req.Project, req.IssueType)
+	if t == nil {
+		return nil
+	}
+
+	data := &templateData{
+		ctx:         ctx,
+		client:      client,
+		Project:     req.Project,
+		IssueType:   req.IssueType,
+		Summary:     req.Summary,
+		Description: req.Description,
+		Date:        time.Now().Format("2006-01-02"),
+	}
+
+	if t.Description != "" {
+		description, err := data.render("description", t.Description)
+		if err != nil {
+			return err
+		}
+		description = strings.TrimSpace(description)
+		if req.Description != "" && !strings.Contains(t.Description, ".Description") {
+			description = req.Description + "\n\n" + description
+		}
+		req.Description = description
+	}
+
+	fields := map[string][]string{}
+	for name, values := range req.Fields {
+		fields[name] = values
+	}
+	given := func(name string) string {
+		for key := range fields {
+			if strings.EqualFold(key, name) {
+				return key
+			}
+		}
+		return ""
+	}
+
+	if len(t.Labels) > 0 {
+		key := given("labels")
+		if key == "" {
+			key = "labels"
+		}
+		for _, label := range t.Labels {
+			label, err := data.render("labels", label)
+			if err != nil {
+				return err
+			}
+			fields[key] = append(fields[key], label)
+		}
+	}
+
+	for name, values := range fieldStrings(t.Fields) {
+		if given(name) != "" {
+			continue
+		}
+		for _, value := range values {
+			value, err := data.render(name, value)
+			if err != nil {
+				return err
+			}
+			fields[name] = append(fields[name], value)
+		}
+	}
+
+	req.Fields = fields
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// stubTemplates replaces the templates in the config directory with the given ones for the rest of the test
+func stubTemplates(t *testing.T, templates ...issueTemplate) {
+	t.Helper()
+	saved := loadTemplates
+	loadTemplates = func() ([]issueTemplate, error) { return templates, nil }
+	t.Cleanup(func() { loadTemplates = saved })
+}
+
+func TestFindTemplate(t *testing.T) {
+	templates := []issueTemplate{
+		{Description: "any"},
+		{Project: "PROJ", Description: "project"},
+		{IssueType: "Bug", Description: "bug"},
+		{Project: "PROJ", IssueType: "Bug", Description: "project bug"},
+	}
+
+	tests := []struct {
+		project, issueType string
+		want               string
+	}{
+		{"PROJ", "bug", "project bug"},
+		{"OTHER", "Bug", "bug"},
+		{"proj", "Story", "project"},
+		{"OTHER", "Story", "any"},
+	}
+	for _, tt := range tests {
+		got := findTemplate(templates, tt.project, tt.issueType)
+		if got == nil || got.Description != tt.want {
+			t.Errorf("findTemplate(%s, %s) = %+v, want %q", tt.project, tt.issueType, got, tt.want)
+		}
+	}
+
+	if got := findTemplate(templates[1:3], "OTHER", "Story"); got != nil {
+		t.Errorf("Expected no template, got %+v", got)
+	}
+}
+
+func TestApplyTemplate(t *testing.T) {
+	stubTemplates(t, issueTemplate{
+		Project:     "PROJ",
+		IssueType:   "Bug",
+		Description: "Reported by {{.User}} for {{.Project}}\n\nh3. Steps to reproduce\n",
+		Labels:      []string{"triage", "{{.IssueType | printf \"%s-report\"}}"},
+		Fields:      map[string]any{"Priority": "High", "Severity": "Minor"},
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "alice", "displayName": "Alice Smith"}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	req := issueRequest{
+		Project:     "PROJ",
+		IssueType:   "Bug",
+		Summary:     "Login broken",
+		Description: "The login button does nothing.",
+		Fields:      map[string][]string{"labels": {"login"}, "severity": {"Critical"}},
+	}
+	if err := applyTemplate(context.Background(), c, &req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantDescription := "The login button does nothing.\n\nReported by Alice Smith for PROJ\n\nh3. Steps to reproduce"
+	if req.Description != wantDescription {
+		t.Errorf("Expected description %q, got %q", wantDescription, req.Description)
+	}
+	if got := strings.Join(req.Fields["labels"], ","); got != "login,triage,Bug-report" {
+		t.Errorf("Expected labels to be added, got %q", got)
+	}
+	if got := strings.Join(req.Fields["Priority"], ","); got != "High" {
+		t.Errorf("Expected priority from the template, got %q", got)
+	}
+	if _, ok := req.Fields["Severity"]; ok || req.Fields["severity"][0] != "Critical" {
+		t.Errorf("Expected the given severity to win, got %v", req.Fields)
+	}
+}
+
+func TestApplyTemplate_PlacesDescription(t *testing.T) {
+	stubTemplates(t, issueTemplate{Description: "h3. Summary\n{{.Description}}\n\nh3. Expected\n"})
+
+	req := issueRequest{Project: "PROJ", IssueType: "Task", Description: "Details"}
+	if err := applyTemplate(context.Background(), nil, &req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := "h3. Summary\nDetails\n\nh3. Expected"; req.Description != want {
+		t.Errorf("Expected description %q, got %q", want, req.Description)
+	}
+}
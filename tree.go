@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// treeConcurrency is how many searches for children are made at the same time
+const treeConcurrency = 8
+
+// treeNode is an issue in a hierarchy tree. Link is set on issues that are linked, rather than children,
+// and describes how they relate to their parent in the tree (e.g. "blocks").
+type treeNode struct {
+	Key      string      `json:"key"`
+	Type     string      `json:"type"`
+	Summary  string      `json:"summary"`
+	Status   string      `json:"status"`
+	Assignee string      `json:"assignee,omitempty"`
+	Link     string      `json:"link,omitempty"`
+	Children []*treeNode `json:"children,omitempty"`
+}
+
+func newTreeNode(issue *jira.Issue) *treeNode {
+	node := &treeNode{Key: issue.Key}
+	if f := issue.Fields; f != nil {
+		node.Summary = f.Summary
+		node.Type = f.Type.Name
+		if f.Status != nil {
+			node.Status = f.Status.Name
+		}
+		if f.Assignee != nil {
+			node.Assignee = f.Assignee.DisplayName
+		}
+	}
+	return node
+}
+
+// treeBuilder fetches the children of the issues in a tree concurrently
+type treeBuilder struct {
+	client *jira.Client
+	links  bool
+	fields []string
+	sem    chan struct{}
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	err    error
+}
+
+// buildTree returns the tree of an issue: an epic's issues, each issue's sub-tasks and, if links is set, the issues linked to each
+func buildTree(ctx context.Context, client *jira.Client, key string, links bool) (*treeNode, error) {
+	fields := []string{"summary", "status", "assignee", "issuetype"}
+	if links {
+		fields = append(fields, "issuelinks")
+	}
+	issue, _, err := client.Issue.GetWithContext(ctx, key, &jira.GetQueryOptions{Fields: strings.Join(fields, ",")})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	b := &treeBuilder{client: client, links: links, fields: fields, sem: make(chan struct{}, treeConcurrency)}
+	root := newTreeNode(issue)
+	b.expand(ctx, root, issue)
+	b.wg.Wait()
+	if b.err != nil {
+		return nil, b.err
+	}
+	return root, nil
+}
+
+// expand adds the children and linked issues of an issue to its node, and expands the children in turn
+func (b *treeBuilder) expand(ctx context.Context, node *treeNode, issue *jira.Issue) {
+	subtask := issue.Fields.Type.Subtask
+	if subtask && !b.links {
+		return
+	}
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		if !subtask {
+			b.sem <- struct{}{}
+			children, err := b.children(ctx, issue)
+			<-b.sem
+			if err != nil {
+				b.mu.Lock()
+				if b.err == nil {
+					b.err = err
+				}
+				b.mu.Unlock()
+				return
+			}
+			for i := range children {
+				child := newTreeNode(&children[i])
+				node.Children = append(node.Children, child)
+				b.expand(ctx, child, &children[i])
+			}
+		}
+		if b.links {
+			for _, link := range issue.Fields.IssueLinks {
+				linked, relation := link.OutwardIssue, link.Type.Outward
+				if linked == nil {
+					linked, relation = link.InwardIssue, link.Type.Inward
+				}
+				if linked == nil {
+					continue
+				}
+				child := newTreeNode(linked)
+				child.Link = relation
+				node.Children = append(node.Children, child)
+			}
+		}
+	}()
+}
+
+// children searches for the issues under an issue: its sub-tasks, or an epic's issues
+func (b *treeBuilder) children(ctx context.Context, issue *jira.Issue) ([]jira.Issue, error) {
+	jql := fmt.Sprintf("parent = %s", issue.Key)
+	if strings.EqualFold(issue.Fields.Type.Name, "Epic") {
+		epicJQL, err := epicChildrenJQL(ctx, b.client, issue.Key)
+		if err != nil {
+			return nil, err
+		}
+		if epicJQL != jql {
+			jql = fmt.Sprintf("(%s OR %s)", jql, epicJQL)
+		}
+	}
+	return searchIssues(ctx, b.client, jql+" ORDER BY key ASC", &jira.SearchOptions{Fields: b.fields})
+}
+
+// formatTree formats a tree with box-drawing characters, one issue per line
+func formatTree(root *treeNode) string {
+	var sb strings.Builder
+	sb.WriteString(root.line() + "\n")
+	writeTreeChildren(&sb, root.Children, "")
+	return sb.String()
+}
+
+func writeTreeChildren(sb *strings.Builder, children []*treeNode, prefix string) {
+	for i, child := range children {
+		branch, indent := "├── ", "│   "
+		if i == len(children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		sb.WriteString(prefix + branch + child.line() + "\n")
+		writeTreeChildren(sb, child.Children, prefix+indent)
+	}
+}
+
+// line describes an issue with its type, status and assignee. Linked issues are fetched without their assignee.
+func (n *treeNode) line() string {
+	if n.Link != "" {
+		return fmt.Sprintf("%s %s [%s] %s (%s)", n.Link, n.Key, n.Type, n.Summary, n.Status)
+	}
+	assignee := n.Assignee
+	if assignee == "" {
+		assignee = "Unassigned"
+	}
+	return fmt.Sprintf("%s [%s] %s (%s, %s)", n.Key, n.Type, n.Summary, n.Status, assignee)
+}
+
+// printTree prints the tree of the current issue as text or json
+func printTree(ctx context.Context, links bool, output string) error {
+	root, err := buildTree(ctx, client, issueKey, links)
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		data, err := json.MarshalIndent(root, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	fmt.Print(formatTree(root))
+	return nil
+}
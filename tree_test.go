@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBuildTree(t *testing.T) {
+	issue := func(key, issueType string, subtask bool, links ...map[string]any) map[string]any {
+		return map[string]any{"key": key, "fields": map[string]any{
+			"summary":    "Summary of " + key,
+			"status":     map[string]any{"name": "To Do"},
+			"issuetype":  map[string]any{"name": issueType, "subtask": subtask},
+			"assignee":   map[string]any{"displayName": "Jane Doe"},
+			"issuelinks": links,
+		}}
+	}
+	children := map[string][]map[string]any{
+		"PROJ-100": {issue("PROJ-1", "Story", false), issue("PROJ-2", "Story", false)},
+		"PROJ-1": {issue("PROJ-3", "Sub-task", true, map[string]any{
+			"type":         map[string]any{"outward": "blocks", "inward": "is blocked by"},
+			"outwardIssue": map[string]any{"key": "PROJ-9", "fields": map[string]any{"summary": "Other", "status": map[string]any{"name": "Done"}, "issuetype": map[string]any{"name": "Bug"}}},
+		})},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/PROJ-100", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(issue("PROJ-100", "Epic", false))
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		jql := r.URL.Query().Get("jql")
+		key := strings.Fields(strings.TrimPrefix(jql, "parent = "))[0]
+		json.NewEncoder(w).Encode(map[string]any{"total": len(children[key]), "issues": children[key]})
+	})
+	c := newTestClient(t, "Cloud", mux)
+
+	root, err := buildTree(context.Background(), c, "PROJ-100", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := `PROJ-100 [Epic] Summary of PROJ-100 (To Do, Jane Doe)
+├── PROJ-1 [Story] Summary of PROJ-1 (To Do, Jane Doe)
+│   └── PROJ-3 [Sub-task] Summary of PROJ-3 (To Do, Jane Doe)
+│       └── blocks PROJ-9 [Bug] Other (Done)
+└── PROJ-2 [Story] Summary of PROJ-2 (To Do, Jane Doe)
+`
+	if got := formatTree(root); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
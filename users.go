@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// searchUsers finds users whose username, email or display name matches the query
+func searchUsers(ctx context.Context, client *jira.Client, query string) ([]jira.User, error) {
+	// go-jira does not escape the query, and emails or display names may contain reserved characters
+	escaped := url.QueryEscape(query)
+
+	var users []jira.User
+	var err error
+	if isCloud(ctx, client) {
+		users, _, err = client.User.FindWithContext(ctx, escaped, jira.WithMaxResults(20))
+	} else {
+		// Server/Data Center ignores "query" and searches on "username" instead
+		users, _, err = client.User.FindWithContext(ctx, escaped, jira.WithUsername(escaped), jira.WithMaxResults(20))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+	return users, nil
+}
+
+// matchUser picks the one user the query refers to from the search results.
+// An exact match on username, email or display name wins over partial matches.
+func matchUser(query string, users []jira.User) (*jira.User, error) {
+	if len(users) == 0 {
+		return nil, fmt.Errorf("no user found matching %q", query)
+	}
+	if len(users) == 1 {
+		return &users[0], nil
+	}
+
+	var exact []jira.User
+	for _, user := range users {
+		localPart, _, _ := strings.Cut(user.EmailAddress, "@")
+		if strings.EqualFold(user.Name, query) ||
+			strings.EqualFold(user.AccountID, query) ||
+			strings.EqualFold(user.EmailAddress, query) ||
+			strings.EqualFold(localPart, query) ||
+			strings.EqualFold(user.DisplayName, query) {
+			exact = append(exact, user)
+		}
+	}
+	if len(exact) == 1 {
+		return &exact[0], nil
+	}
+
+	candidates := exact
+	if len(candidates) == 0 {
+		candidates = users
+	}
+	var suggestions []string
+	for _, user := range candidates {
+		suggestions = append(suggestions, userLabel(user))
+	}
+	return nil, fmt.Errorf("%q matches %d users, did you mean one of: %s", query, len(candidates), strings.Join(suggestions, ", "))
+}
+
+// userLabel formats a user for display, e.g. "Alice Smith (alice)"
+func userLabel(user jira.User) string {
+	id := user.Name
+	if id == "" {
+		id = user.AccountID
+	}
+	if user.EmailAddress != "" {
+		return fmt.Sprintf("%s (%s, %s)", user.DisplayName, id, user.EmailAddress)
+	}
+	return fmt.Sprintf("%s (%s)", user.DisplayName, id)
+}
+
+// resolveUser finds the user referred to by a username, email, display name or "me"
+func resolveUser(ctx context.Context, client *jira.Client, query string) (*jira.User, error) {
+	if strings.EqualFold(query, "me") {
+		user, _, err := client.User.GetSelfWithContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current user: %w", err)
+		}
+		return user, nil
+	}
+
+	users, err := searchUsers(ctx, client, query)
+	if err != nil {
+		return nil, err
+	}
+	return matchUser(query, users)
+}
+
+// resolveAssignee is like resolveUser but also accepts "none", for which it returns nil to mean unassigned
+func resolveAssignee(ctx context.Context, client *jira.Client, assignee string) (*jira.User, error) {
+	if strings.EqualFold(assignee, "none") {
+		return nil, nil
+	}
+	return resolveUser(ctx, client, assignee)
+}
+
+// sameUser reports whether two users are the same, by account ID on Cloud and by username on Server/Data Center
+func sameUser(a, b *jira.User) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	if a.AccountID != "" || b.AccountID != "" {
+		return a.AccountID == b.AccountID
+	}
+	return a.Name != "" && a.Name == b.Name
+}
+
+// userRef returns the reference used to set a user field, the accountId on Cloud and the username on Server/Data Center
+func userRef(ctx context.Context, client *jira.Client, user *jira.User) *jira.User {
+	if isCloud(ctx, client) {
+		return &jira.User{AccountID: user.AccountID}
+	}
+	return &jira.User{Name: user.Name}
+}
+
+// setAssignee assigns the issue to the user, or unassigns it if user is nil
+func setAssignee(ctx context.Context, client *jira.Client, key string, user *jira.User) error {
+	if user != nil {
+		_, err := client.Issue.UpdateAssigneeWithContext(ctx, key, userRef(ctx, client, user))
+		return err
+	}
+
+	// Unassigning needs an explicit null, which go-jira's User would omit
+	field := "name"
+	if isCloud(ctx, client) {
+		field = "accountId"
+	}
+	req, err := client.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("rest/api/2/issue/%s/assignee", key), map[string]any{field: nil})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, nil)
+	return err
+}
+
+// formatUsers formats users as a table of id, display name and email
+func formatUsers(users []jira.User) string {
+	var sb strings.Builder
+	for _, user := range users {
+		id := user.Name
+		if id == "" {
+			id = user.AccountID
+		}
+		fmt.Fprintf(&sb, "%-30s %-30s %s\n", id, user.DisplayName, user.EmailAddress)
+	}
+	return sb.String()
+}
+
+// findUser lists users matching the query
+func findUser(ctx context.Context, query string) error {
+	users, err := searchUsers(ctx, client, query)
+	if err != nil {
+		return err
+	}
+
+	if len(users) == 0 {
+		fmt.Printf("No users found matching %q\n", query)
+		return nil
+	}
+
+	fmt.Print(formatUsers(users))
+	return nil
+}
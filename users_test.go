@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestResolveAssignee(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/user/search", userSearchHandler(testUsers))
+	mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(testUsers[2])
+	})
+	c := newTestClient(t, "Server", mux)
+	ctx := context.Background()
+
+	tests := []struct {
+		assignee string
+		want     string
+	}{
+		{"me", "bob"},
+		{"carol@example.com", "carol"},
+		{"Alice Jones", "alice.jones"},
+		{"none", ""},
+	}
+	for _, tt := range tests {
+		user, err := resolveAssignee(ctx, c, tt.assignee)
+		if err != nil {
+			t.Errorf("resolveAssignee(%q) unexpected error: %v", tt.assignee, err)
+			continue
+		}
+		got := ""
+		if user != nil {
+			got = user.Name
+		}
+		if got != tt.want {
+			t.Errorf("resolveAssignee(%q) = %q, want %q", tt.assignee, got, tt.want)
+		}
+	}
+}
+
+func TestSetAssignee(t *testing.T) {
+	tests := []struct {
+		deploymentType string
+		user           *jira.User
+		field          string
+		want           any
+	}{
+		{"Server", &testUsers[0], "name", "alice"},
+		{"Cloud", &testUsers[0], "accountId", "acc-alice"},
+		{"Server", nil, "name", nil},
+		{"Cloud", nil, "accountId", nil},
+	}
+	for _, tt := range tests {
+		var body map[string]any
+		mux := http.NewServeMux()
+		mux.HandleFunc("/rest/api/2/issue/TEST-1/assignee", func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&body)
+			w.WriteHeader(http.StatusNoContent)
+		})
+		c := newTestClient(t, tt.deploymentType, mux)
+
+		if err := setAssignee(context.Background(), c, "TEST-1", tt.user); err != nil {
+			t.Errorf("setAssignee on %s unexpected error: %v", tt.deploymentType, err)
+		}
+		if got, ok := body[tt.field]; !ok || got != tt.want {
+			t.Errorf("setAssignee on %s sent %v, want %s=%v", tt.deploymentType, body, tt.field, tt.want)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// getVersions returns the versions of a project, in the project's order
+func getVersions(ctx context.Context, client *jira.Client, projectKey string) ([]jira.Version, error) {
+	req, err := client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("rest/api/2/project/%s/versions", projectKey), nil)
+	if err != nil {
+		return nil, err
+	}
+	var versions []jira.Version
+	if _, err := client.Do(req, &versions); err != nil {
+		return nil, fmt.Errorf("failed to get versions: %w", err)
+	}
+	return versions, nil
+}
+
+// findVersion finds a version of a project by name, ignoring case
+func findVersion(ctx context.Context, client *jira.Client, projectKey, name string) (*jira.Version, error) {
+	versions, err := getVersions(ctx, client, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for i, version := range versions {
+		if strings.EqualFold(version.Name, name) {
+			return &versions[i], nil
+		}
+		names = append(names, fmt.Sprintf("%q", version.Name))
+	}
+	return nil, fmt.Errorf("version %q not found in project %s. Available versions: %s", name, projectKey, strings.Join(names, ", "))
+}
+
+// versionState describes whether a version is released or archived
+func versionState(version jira.Version) string {
+	switch {
+	case version.Archived != nil && *version.Archived:
+		return "archived"
+	case version.Released != nil && *version.Released:
+		return "released"
+	}
+	return "unreleased"
+}
+
+// formatVersions formats versions as a table of name, state, release date and description
+func formatVersions(versions []jira.Version) string {
+	var sb strings.Builder
+	for _, version := range versions {
+		fmt.Fprintf(&sb, "%-20s %-12s %-12s %s\n", version.Name, versionState(version), version.ReleaseDate, version.Description)
+	}
+	return sb.String()
+}
+
+// listVersions lists the versions of a project
+func listVersions(ctx context.Context, projectKey string) error {
+	versions, err := getVersions(ctx, client, projectKey)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		fmt.Printf("Project %s has no versions\n", projectKey)
+		return nil
+	}
+	fmt.Print(formatVersions(versions))
+	return nil
+}
+
+// createVersion adds a version to a project
+func createVersion(ctx context.Context, projectKey string, version jira.Version) error {
+	project, _, err := client.Project.GetWithContext(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	if version.ProjectID, err = strconv.Atoi(project.ID); err != nil {
+		return fmt.Errorf("unexpected project ID %q: %w", project.ID, err)
+	}
+
+	created, _, err := client.Version.CreateWithContext(ctx, &version)
+	if err != nil {
+		return fmt.Errorf("failed to create version: %w", err)
+	}
+
+	fmt.Printf("Created version %s in %s\n", created.Name, projectKey)
+	return nil
+}
+
+// releaseVersion marks a version as released on the date, today if empty, and warns about unresolved issues still in it
+func releaseVersion(ctx context.Context, projectKey, name, date string) error {
+	version, err := findVersion(ctx, client, projectKey, name)
+	if err != nil {
+		return err
+	}
+	if date == "" {
+		date = time.Now().Format(time.DateOnly)
+	}
+
+	released := true
+	if _, _, err := client.Version.UpdateWithContext(ctx, &jira.Version{ID: version.ID, Released: &released, ReleaseDate: date}); err != nil {
+		return fmt.Errorf("failed to release version: %w", err)
+	}
+	fmt.Printf("Released %s %s on %s\n", projectKey, version.Name, date)
+
+	jql := fmt.Sprintf("project = %s AND fixVersion = %s AND resolution = Unresolved", quoteJQL(projectKey), quoteJQL(version.Name))
+	if _, resp, err := client.Issue.SearchWithContext(ctx, jql, &jira.SearchOptions{MaxResults: 1, Fields: []string{"key"}}); err == nil && resp.Total > 0 {
+		fmt.Printf("Warning: %d unresolved issue(s) still have fix version %s\n", resp.Total, version.Name)
+	}
+	return nil
+}
+
+// archiveVersion archives a version, hiding it from version pickers
+func archiveVersion(ctx context.Context, projectKey, name string) error {
+	version, err := findVersion(ctx, client, projectKey, name)
+	if err != nil {
+		return err
+	}
+
+	archived := true
+	if _, _, err := client.Version.UpdateWithContext(ctx, &jira.Version{ID: version.ID, Archived: &archived}); err != nil {
+		return fmt.Errorf("failed to archive version: %w", err)
+	}
+
+	fmt.Printf("Archived %s %s\n", projectKey, version.Name)
+	return nil
+}
+
+// issueGroup is the issues of one issue type
+type issueGroup struct {
+	Name   string
+	Issues []jira.Issue
+}
+
+// groupByIssueType groups issues by issue type, keeping the order in which the types first appear
+func groupByIssueType(issues []jira.Issue) []issueGroup {
+	var groups []issueGroup
+	index := map[string]int{}
+	for _, issue := range issues {
+		name := issue.Fields.Type.Name
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, issueGroup{Name: name})
+		}
+		groups[i].Issues = append(groups[i].Issues, issue)
+	}
+	return groups
+}
+
+// formatReleaseNotes formats the issues of a version as release notes in markdown or html, grouped by issue type
+func formatReleaseNotes(host, projectKey string, version *jira.Version, issues []jira.Issue, format string) (string, error) {
+	title := fmt.Sprintf("%s %s Release Notes", projectKey, version.Name)
+	var intro []string
+	if version.Released != nil && *version.Released && version.ReleaseDate != "" {
+		intro = append(intro, "Released "+version.ReleaseDate)
+	}
+	if version.Description != "" {
+		intro = append(intro, version.Description)
+	}
+	groups := groupByIssueType(issues)
+
+	var sb strings.Builder
+	switch format {
+	case "markdown", "md":
+		fmt.Fprintf(&sb, "# %s\n", title)
+		for _, line := range intro {
+			fmt.Fprintf(&sb, "\n%s\n", line)
+		}
+		if len(issues) == 0 {
+			sb.WriteString("\nNo issues.\n")
+		}
+		for _, group := range groups {
+			fmt.Fprintf(&sb, "\n## %s\n\n", group.Name)
+			for _, issue := range group.Issues {
+				fmt.Fprintf(&sb, "- [%s](https://%s/browse/%s) %s\n", issue.Key, host, issue.Key, issue.Fields.Summary)
+			}
+		}
+	case "html":
+		fmt.Fprintf(&sb, "<h1>%s</h1>\n", html.EscapeString(title))
+		for _, line := range intro {
+			fmt.Fprintf(&sb, "<p>%s</p>\n", html.EscapeString(line))
+		}
+		if len(issues) == 0 {
+			sb.WriteString("<p>No issues.</p>\n")
+		}
+		for _, group := range groups {
+			fmt.Fprintf(&sb, "<h2>%s</h2>\n<ul>\n", html.EscapeString(group.Name))
+			for _, issue := range group.Issues {
+				fmt.Fprintf(&sb, "<li><a href=\"https://%s/browse/%s\">%s</a> %s</li>\n", host, issue.Key, issue.Key, html.EscapeString(issue.Fields.Summary))
+			}
+			sb.WriteString("</ul>\n")
+		}
+	default:
+		return "", fmt.Errorf("unknown format %q: expected markdown or html", format)
+	}
+	return sb.String(), nil
+}
+
+// releaseNotes prints release notes for a version of a project: its issues, other than sub-tasks, grouped by issue type
+func releaseNotes(ctx context.Context, projectKey, name, format string) error {
+	version, err := findVersion(ctx, client, projectKey, name)
+	if err != nil {
+		return err
+	}
+
+	jql := fmt.Sprintf("project = %s AND fixVersion = %s AND issuetype not in subTaskIssueTypes() ORDER BY issuetype ASC, key ASC", quoteJQL(projectKey), quoteJQL(version.Name))
+	issues, err := searchIssues(ctx, client, jql, &jira.SearchOptions{Fields: []string{"summary", "issuetype"}})
+	if err != nil {
+		return err
+	}
+
+	notes, err := formatReleaseNotes(host, projectKey, version, issues, format)
+	if err != nil {
+		return err
+	}
+	fmt.Print(notes)
+	return nil
+}
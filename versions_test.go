@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func testIssue(key, issueType, summary string) jira.Issue {
+	return jira.Issue{Key: key, Fields: &jira.IssueFields{Summary: summary, Type: jira.IssueType{Name: issueType}}}
+}
+
+func TestFormatReleaseNotes(t *testing.T) {
+	released := true
+	version := &jira.Version{Name: "2.1.0", Released: &released, ReleaseDate: "2026-10-02"}
+	issues := []jira.Issue{
+		testIssue("PROJ-120", "Story", "Add dark mode"),
+		testIssue("PROJ-118", "Bug", "Login <button> not working"),
+		testIssue("PROJ-121", "Story", "Add search"),
+	}
+
+	markdown, err := formatReleaseNotes("jira.example.com", "PROJ", version, issues, "markdown")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantMarkdown := `# PROJ 2.1.0 Release Notes
+
+Released 2026-10-02
+
+## Story
+
+- [PROJ-120](https://jira.example.com/browse/PROJ-120) Add dark mode
+- [PROJ-121](https://jira.example.com/browse/PROJ-121) Add search
+
+## Bug
+
+- [PROJ-118](https://jira.example.com/browse/PROJ-118) Login <button> not working
+`
+	if markdown != wantMarkdown {
+		t.Errorf("Expected:\n%s\ngot:\n%s", wantMarkdown, markdown)
+	}
+
+	htmlNotes, err := formatReleaseNotes("jira.example.com", "PROJ", version, issues, "html")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"<h1>PROJ 2.1.0 Release Notes</h1>\n<p>Released 2026-10-02</p>\n<h2>Story</h2>\n<ul>\n",
+		`<li><a href="https://jira.example.com/browse/PROJ-118">PROJ-118</a> Login &lt;button&gt; not working</li>`,
+	} {
+		if !strings.Contains(htmlNotes, want) {
+			t.Errorf("Expected html to contain %q, got:\n%s", want, htmlNotes)
+		}
+	}
+
+	if _, err := formatReleaseNotes("jira.example.com", "PROJ", version, issues, "pdf"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
+
+func TestFindVersion(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/project/PROJ/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": "1", "name": "2.0.0", "released": true}, {"id": "2", "name": "2.1.0-RC"}]`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	version, err := findVersion(context.Background(), c, "PROJ", "2.1.0-rc")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if version.ID != "2" || versionState(*version) != "unreleased" {
+		t.Errorf("Unexpected version: %+v", version)
+	}
+
+	_, err = findVersion(context.Background(), c, "PROJ", "3.0")
+	if err == nil || !strings.Contains(err.Error(), `Available versions: "2.0.0", "2.1.0-RC"`) {
+		t.Errorf("Expected the available versions in the error, got: %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// issueSnapshot is the state of an issue that watch-jql reports changes to
+type issueSnapshot struct {
+	Key           string
+	Summary       string
+	Status        string
+	Assignee      string
+	Comments      int
+	CommentAuthor string
+	CommentBody   string
+}
+
+// watchEvent is a change to the issues matching a query between two polls
+type watchEvent struct {
+	Time    time.Time `json:"time"`
+	Type    string    `json:"type"`
+	Key     string    `json:"key"`
+	Summary string    `json:"summary"`
+	From    string    `json:"from,omitempty"`
+	To      string    `json:"to,omitempty"`
+}
+
+// takeSnapshot searches for the issues matching JQL and returns their state by key
+func takeSnapshot(ctx context.Context, client *jira.Client, jql string) (map[string]issueSnapshot, error) {
+	issues, err := searchIssues(ctx, client, jql, &jira.SearchOptions{Fields: []string{"summary", "status", "assignee", "comment"}})
+	if err != nil {
+		return nil, err
+	}
+	snapshot := map[string]issueSnapshot{}
+	for _, issue := range issues {
+		f := issue.Fields
+		s := issueSnapshot{Key: issue.Key, Summary: f.Summary}
+		if f.Status != nil {
+			s.Status = f.Status.Name
+		}
+		if f.Assignee != nil {
+			s.Assignee = f.Assignee.DisplayName
+		}
+		if f.Comments != nil && len(f.Comments.Comments) > 0 {
+			last := f.Comments.Comments[len(f.Comments.Comments)-1]
+			s.Comments, s.CommentAuthor, s.CommentBody = len(f.Comments.Comments), last.Author.DisplayName, last.Body
+		}
+		snapshot[issue.Key] = s
+	}
+	return snapshot, nil
+}
+
+// diffSnapshots returns the events between two snapshots, by issue key: issues that appeared or disappeared,
+// changed status or assignee, or received comments
+func diffSnapshots(before, after map[string]issueSnapshot, now time.Time) []watchEvent {
+	var events []watchEvent
+	event := func(eventType string, s issueSnapshot, from, to string) {
+		events = append(events, watchEvent{Time: now, Type: eventType, Key: s.Key, Summary: s.Summary, From: from, To: to})
+	}
+	for key, s := range after {
+		old, ok := before[key]
+		if !ok {
+			event("added", s, "", s.Status)
+			continue
+		}
+		if old.Status != s.Status {
+			event("status", s, old.Status, s.Status)
+		}
+		if old.Assignee != s.Assignee {
+			event("assigned", s, old.Assignee, s.Assignee)
+		}
+		if s.Comments > old.Comments {
+			event("commented", s, s.CommentAuthor, historyValue(s.CommentBody))
+		}
+	}
+	for key, s := range before {
+		if _, ok := after[key]; !ok {
+			event("removed", s, s.Status, "")
+		}
+	}
+
+	order := map[string]int{"added": 0, "status": 1, "assigned": 2, "commented": 3, "removed": 4}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Key != events[j].Key {
+			return events[i].Key < events[j].Key
+		}
+		return order[events[i].Type] < order[events[j].Type]
+	})
+	return events
+}
+
+// formatWatchEvent formats an event as one line
+func formatWatchEvent(e watchEvent) string {
+	var what string
+	switch e.Type {
+	case "added":
+		what = fmt.Sprintf("now matches (%s)", e.To)
+	case "removed":
+		what = "no longer matches"
+	case "status":
+		what = fmt.Sprintf("status %s -> %s", e.From, e.To)
+	case "assigned":
+		what = fmt.Sprintf("assignee %s -> %s", historyValue(e.From), historyValue(e.To))
+	case "commented":
+		what = fmt.Sprintf("comment by %s: %s", e.From, e.To)
+	}
+	return fmt.Sprintf("%s  %-15s %s  %s\n", e.Time.Format("2006-01-02 15:04:05"), e.Key, what, e.Summary)
+}
+
+// runWatchHook runs a shell command for an event, with the event as JSON on its standard input and its
+// fields in JIRA_EVENT, JIRA_ISSUE, JIRA_SUMMARY, JIRA_FROM and JIRA_TO
+func runWatchHook(ctx context.Context, command string, e watchEvent) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	cmd.Env = append(os.Environ(),
+		"JIRA_EVENT="+e.Type,
+		"JIRA_ISSUE="+e.Key,
+		"JIRA_SUMMARY="+e.Summary,
+		"JIRA_FROM="+e.From,
+		"JIRA_TO="+e.To,
+	)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("hook failed for %s %s: %w", e.Type, e.Key, err)
+	}
+	return nil
+}
+
+// watchJQL polls the issues matching JQL every interval until the context is cancelled, and writes the
+// events between polls as text or JSON lines, running the hook command, if any, for each. The first
+// poll only sets the baseline. A failed poll or hook is reported on stderr and watching carries on.
+func watchJQL(ctx context.Context, client *jira.Client, jql string, interval time.Duration, output, hook string, w io.Writer) error {
+	before, err := takeSnapshot(ctx, client, jql)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Watching %d issue(s) matching %s, every %s\n", len(before), jql, interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	enc := json.NewEncoder(w)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		after, err := takeSnapshot(ctx, client, jql)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			continue
+		}
+		for _, e := range diffSnapshots(before, after, time.Now()) {
+			if output == "json" {
+				if err := enc.Encode(e); err != nil {
+					return err
+				}
+			} else {
+				fmt.Fprint(w, formatWatchEvent(e))
+			}
+			if hook != "" {
+				if err := runWatchHook(ctx, hook, e); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				}
+			}
+		}
+		before = after
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTakeSnapshot(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"total": 1, "issues": [
+			{"key": "OPS-1", "fields": {"summary": "Payments down", "status": {"name": "Open"}, "assignee": {"displayName": "Jane Doe"},
+				"comment": {"comments": [
+					{"author": {"displayName": "Bob Smith"}, "body": "Looking"},
+					{"author": {"displayName": "Jane Doe"}, "body": "Rolled back"}
+				]}}}
+		]}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	snapshot, err := takeSnapshot(context.Background(), c, "project = OPS")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := issueSnapshot{Key: "OPS-1", Summary: "Payments down", Status: "Open", Assignee: "Jane Doe", Comments: 2, CommentAuthor: "Jane Doe", CommentBody: "Rolled back"}
+	if got := snapshot["OPS-1"]; got != want {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	now := time.Date(2026, 10, 18, 2, 16, 0, 0, time.UTC)
+	before := map[string]issueSnapshot{
+		"OPS-1": {Key: "OPS-1", Summary: "Payments down", Status: "Open"},
+		"OPS-2": {Key: "OPS-2", Summary: "Disk full", Status: "Open", Assignee: "Bob Smith", Comments: 1},
+		"OPS-3": {Key: "OPS-3", Summary: "Unchanged", Status: "Open"},
+	}
+	after := map[string]issueSnapshot{
+		"OPS-2": {Key: "OPS-2", Summary: "Disk full", Status: "In Progress", Assignee: "Jane Doe", Comments: 2, CommentAuthor: "Jane Doe", CommentBody: "Cleared /tmp"},
+		"OPS-3": {Key: "OPS-3", Summary: "Unchanged", Status: "Open"},
+		"OPS-4": {Key: "OPS-4", Summary: "Queue backlog", Status: "Open"},
+	}
+
+	var sb strings.Builder
+	for _, e := range diffSnapshots(before, after, now) {
+		sb.WriteString(formatWatchEvent(e))
+	}
+	want := `2026-10-18 02:16:00  OPS-1           no longer matches  Payments down
+2026-10-18 02:16:00  OPS-2           status Open -> In Progress  Disk full
+2026-10-18 02:16:00  OPS-2           assignee Bob Smith -> Jane Doe  Disk full
+2026-10-18 02:16:00  OPS-2           comment by Jane Doe: Cleared /tmp  Disk full
+2026-10-18 02:16:00  OPS-4           now matches (Open)  Queue backlog
+`
+	if got := sb.String(); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	if events := diffSnapshots(after, after, now); len(events) != 0 {
+		t.Errorf("Expected no events, got %+v", events)
+	}
+}
+
+func TestWatchJQL(t *testing.T) {
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "Open"
+		if polls > 1 {
+			status = "Closed"
+		}
+		w.Write([]byte(`{"total": 1, "issues": [{"key": "OPS-1", "fields": {"summary": "Payments down", "status": {"name": "` + status + `"}}}]}`))
+	})
+	c := newTestClient(t, "Server", mux)
+
+	out := filepath.Join(t.TempDir(), "hook.json")
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	var buf bytes.Buffer
+	if err := watchJQL(ctx, c, "project = OPS", 20*time.Millisecond, "json", `cat > "`+out+`"; echo "$JIRA_EVENT $JIRA_ISSUE" >> "`+out+`"`, &buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("Expected one event, got %q", buf.String())
+	}
+	var e watchEvent
+	if err := json.Unmarshal([]byte(lines[0]), &e); err != nil {
+		t.Fatalf("Expected a JSON line, got %q: %v", lines[0], err)
+	}
+	if e.Type != "status" || e.Key != "OPS-1" || e.From != "Open" || e.To != "Closed" {
+		t.Errorf("Unexpected event: %+v", e)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("Expected the hook to run: %v", err)
+	}
+	if !strings.Contains(string(data), `"type":"status"`) || !strings.HasSuffix(string(data), "status OPS-1\n") {
+		t.Errorf("Unexpected hook output: %q", data)
+	}
+}
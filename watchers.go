@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+// The watcher endpoints are called directly: go-jira's GetWatchers panics on Server/Data Center, where
+// watchers have no account ID, and its RemoveWatcher sends the user in the body rather than the query.
+
+// issueWatchers is the list of users watching an issue
+type issueWatchers struct {
+	WatchCount int         `json:"watchCount"`
+	IsWatching bool        `json:"isWatching"`
+	Watchers   []jira.User `json:"watchers"`
+}
+
+// getWatchers returns the users watching an issue
+func getWatchers(ctx context.Context, client *jira.Client, key string) (*issueWatchers, error) {
+	req, err := client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("rest/api/2/issue/%s/watchers", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	var watchers issueWatchers
+	if _, err := client.Do(req, &watchers); err != nil {
+		return nil, fmt.Errorf("failed to get watchers: %w", err)
+	}
+	return &watchers, nil
+}
+
+// addWatcher makes a user watch an issue
+func addWatcher(ctx context.Context, client *jira.Client, key string, user *jira.User) error {
+	// The body is the account ID on Cloud, or the username on Server/Data Center, as a JSON string
+	_, id := userParam(ctx, client, user)
+	req, err := client.NewRequestWithContext(ctx, "POST", fmt.Sprintf("rest/api/2/issue/%s/watchers", key), id)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return fmt.Errorf("failed to add watcher: %w", err)
+	}
+	return nil
+}
+
+// removeWatcher stops a user watching an issue
+func removeWatcher(ctx context.Context, client *jira.Client, key string, user *jira.User) error {
+	param, id := userParam(ctx, client, user)
+	req, err := client.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("rest/api/2/issue/%s/watchers?%s=%s", key, param, url.QueryEscape(id)), nil)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return fmt.Errorf("failed to remove watcher: %w", err)
+	}
+	return nil
+}
+
+// userParam returns the query parameter and value that identify a user: accountId on Cloud, username on Server/Data Center
+func userParam(ctx context.Context, client *jira.Client, user *jira.User) (string, string) {
+	ref := userRef(ctx, client, user)
+	if ref.AccountID != "" {
+		return "accountId", ref.AccountID
+	}
+	return "username", ref.Name
+}
+
+// setVote adds or removes the current user's vote for an issue
+func setVote(ctx context.Context, client *jira.Client, key string, vote bool) error {
+	method := "POST"
+	if !vote {
+		method = "DELETE"
+	}
+	req, err := client.NewRequestWithContext(ctx, method, fmt.Sprintf("rest/api/2/issue/%s/votes", key), nil)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		if vote {
+			return fmt.Errorf("failed to vote (you cannot vote for issues you reported, or resolved issues): %w", err)
+		}
+		return fmt.Errorf("failed to remove vote: %w", err)
+	}
+	return nil
+}
+
+// addWatchers adds each of the users to the watchers of an issue, and describes what happened
+func addWatchers(ctx context.Context, client *jira.Client, key string, users []string) string {
+	var added, failed []string
+	for _, query := range users {
+		user, err := resolveUser(ctx, client, query)
+		if err == nil {
+			err = addWatcher(ctx, client, key, user)
+		}
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("Failed to add watcher %s: %v", query, err))
+			continue
+		}
+		added = append(added, user.DisplayName)
+	}
+
+	var sb strings.Builder
+	if len(added) > 0 {
+		fmt.Fprintf(&sb, "Added watchers: %s\n", strings.Join(added, ", "))
+	}
+	for _, f := range failed {
+		fmt.Fprintln(&sb, f)
+	}
+	return sb.String()
+}
+
+// formatWatchers formats the watchers of an issue as a table of users
+func formatWatchers(key string, watchers *issueWatchers) string {
+	if len(watchers.Watchers) == 0 {
+		return fmt.Sprintf("No one is watching %s\n", key)
+	}
+	return fmt.Sprintf("%d watcher(s) of %s:\n\n%s", watchers.WatchCount, key, formatUsers(watchers.Watchers))
+}
+
+// watchIssue makes the user, by default the current user, watch the current issue
+func watchIssue(ctx context.Context, who string) error {
+	user, err := resolveUser(ctx, client, who)
+	if err != nil {
+		return err
+	}
+	if err := addWatcher(ctx, client, issueKey, user); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s is now watching %s\n", user.DisplayName, issueKey)
+	return nil
+}
+
+// unwatchIssue stops the user, by default the current user, watching the current issue
+func unwatchIssue(ctx context.Context, who string) error {
+	user, err := resolveUser(ctx, client, who)
+	if err != nil {
+		return err
+	}
+	if err := removeWatcher(ctx, client, issueKey, user); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s is no longer watching %s\n", user.DisplayName, issueKey)
+	return nil
+}
+
+// listWatchers lists the users watching the current issue
+func listWatchers(ctx context.Context) error {
+	watchers, err := getWatchers(ctx, client, issueKey)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatWatchers(issueKey, watchers))
+	return nil
+}
+
+// voteIssue adds or removes the current user's vote for the current issue
+func voteIssue(ctx context.Context, vote bool) error {
+	if err := setVote(ctx, client, issueKey, vote); err != nil {
+		return err
+	}
+
+	if vote {
+		fmt.Printf("Voted for %s\n", issueKey)
+	} else {
+		fmt.Printf("Removed your vote for %s\n", issueKey)
+	}
+	return nil
+}
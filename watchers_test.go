@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWatchers(t *testing.T) {
+	tests := []struct {
+		deploymentType string
+		wantBody       string
+		wantQuery      string
+	}{
+		{"Server", `"bob"`, "username=bob"},
+		{"Cloud", `"acc-bob"`, "accountId=acc-bob"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.deploymentType, func(t *testing.T) {
+			var body, query string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rest/api/2/user/search", userSearchHandler(testUsers))
+			mux.HandleFunc("POST /rest/api/2/issue/PROJ-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				body = strings.TrimSpace(string(data))
+				w.WriteHeader(http.StatusNoContent)
+			})
+			mux.HandleFunc("DELETE /rest/api/2/issue/PROJ-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.RawQuery
+				w.WriteHeader(http.StatusNoContent)
+			})
+			c := newTestClient(t, tt.deploymentType, mux)
+
+			if got := addWatchers(context.Background(), c, "PROJ-1", []string{"bob", "nobody"}); !strings.Contains(got, "Added watchers: Bob Brown") || !strings.Contains(got, "Failed to add watcher nobody") {
+				t.Errorf("Unexpected result: %s", got)
+			}
+			if body != tt.wantBody {
+				t.Errorf("Expected body %s, got %s", tt.wantBody, body)
+			}
+
+			if err := removeWatcher(context.Background(), c, "PROJ-1", &testUsers[2]); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if query != tt.wantQuery {
+				t.Errorf("Expected query %s, got %s", tt.wantQuery, query)
+			}
+		})
+	}
+}
+
+func TestFormatWatchers(t *testing.T) {
+	watchers := &issueWatchers{WatchCount: 1, Watchers: testUsers[:1]}
+	want := "1 watcher(s) of PROJ-1:\n\nalice                          Alice Smith                    alice@example.com\n"
+	if got := formatWatchers("PROJ-1", watchers); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+	if got := formatWatchers("PROJ-1", &issueWatchers{}); got != "No one is watching PROJ-1\n" {
+		t.Errorf("Unexpected output for no watchers: %q", got)
+	}
+}